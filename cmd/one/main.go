@@ -92,24 +92,13 @@ type lintResult struct {
 	numFilesChecked int
 }
 
-var defaultIgnoreDirs = []string{
-	"node_modules",
-	"vendor",
-	"dist",
-	"build",
-	"out",
-	".git",
-	".svn",
-	"venv",
-	"__pycache__",
-	".idea",
-}
-
 // RunLints goes over all the files in the project and runs the lints for every file encountered
 func RunLints(
 	rootDir string,
 	globPattern string, // pattern to ignore files
 	patternRules map[one.Language][]one.PatternRule, // map of language id -> yaml rules
+	positions one.PositionFormat, // how lines and columns are numbered in the output
+	extensions []string, // if not empty, only files with these extensions are linted
 ) error {
 	ignorePattern, err := glob.Compile(globPattern)
 	if err != nil {
@@ -122,7 +111,7 @@ func RunLints(
 	result := lintResult{}
 	err = filepath.Walk(rootDir, func(path string, d fs.FileInfo, err error) error {
 		if d.IsDir() {
-			if ignorePattern.Match(path) || slices.Contains(defaultIgnoreDirs, d.Name()) {
+			if ignorePattern.Match(path) || slices.Contains(one.DefaultIgnoreDirs, d.Name()) {
 				return filepath.SkipDir
 			}
 
@@ -134,7 +123,7 @@ func RunLints(
 			return nil
 		}
 
-		if ignorePattern.Match(path) {
+		if ignorePattern.Match(path) || !one.HasAllowedExtension(path, extensions) {
 			return nil
 		}
 
@@ -153,12 +142,17 @@ func RunLints(
 		}
 
 		for _, issue := range issues {
+			line, column := positions.LineAndColumn(issue.Range.StartPoint)
 			log.Error().Msgf("[Ln %d:Col %d] %s",
-				issue.Range.StartPoint.Row,
-				issue.Range.StartPoint.Column,
+				line,
+				column,
 				color.YellowString(issue.Message),
 			)
 
+			if url := issue.Metadata[one.MetadataDocsURL]; url != "" {
+				log.Info().Msgf("  See: %s", url)
+			}
+
 			result.issues = append(result.issues, issue)
 		}
 
@@ -188,6 +182,7 @@ func main() {
 	}
 
 	cmd := &cli.Command{
+		Version: one.Version,
 		Commands: []*cli.Command{
 			{
 				Name:    "lint",
@@ -199,6 +194,15 @@ func main() {
 						Usage:   "Ignore file paths that match a pattern",
 						Aliases: []string{"i"},
 					},
+					&cli.StringSliceFlag{
+						Name:    "ext",
+						Usage:   "Only lint files with these extensions (e.g: --ext .ts --ext .tsx)",
+						Aliases: []string{"e"},
+					},
+					&cli.BoolFlag{
+						Name:  "zero-based",
+						Usage: "Number lines and columns from 0 instead of 1 in the output",
+					},
 				},
 				Action: func(ctx context.Context, cmd *cli.Command) error {
 					rootDir, err := os.Getwd()
@@ -207,7 +211,11 @@ func main() {
 					}
 
 					ignorePattern := cmd.String("ignore")
-					return RunLints(rootDir, ignorePattern, patternRules)
+					positions := one.PositionFormat{
+						ZeroBasedLines:   cmd.Bool("zero-based"),
+						ZeroBasedColumns: cmd.Bool("zero-based"),
+					}
+					return RunLints(rootDir, ignorePattern, patternRules, positions, cmd.StringSlice("ext"))
 				},
 			},
 			{
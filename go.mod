@@ -2,18 +2,21 @@ module github.com/srijan-paul/deepgrep
 
 go 1.23.2
 
+require (
+	github.com/fatih/color v1.18.0
+	github.com/gobwas/glob v0.2.3
+	github.com/rs/zerolog v1.33.0
+	github.com/smacker/go-tree-sitter v0.0.0-20240827094217-dd81d9e9be82
+	github.com/stretchr/testify v1.10.0
+	github.com/urfave/cli/v3 v3.0.0-beta1
+	gopkg.in/yaml.v3 v3.0.1
+)
+
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/emicklei/dot v1.6.4 // indirect
-	github.com/fatih/color v1.18.0 // indirect
-	github.com/gobwas/glob v0.2.3 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/rs/zerolog v1.33.0 // indirect
-	github.com/smacker/go-tree-sitter v0.0.0-20240827094217-dd81d9e9be82 // indirect
-	github.com/stretchr/testify v1.10.0 // indirect
-	github.com/urfave/cli/v3 v3.0.0-beta1 // indirect
 	golang.org/x/sys v0.25.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -1,12 +1,51 @@
 package one
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
+type Severity int
+
+const (
+	SeverityInfo Severity = iota - 1
+	// SeverityWarning is the default severity for issues
+	SeverityWarning
+	SeverityError
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// RelatedLocation is a location in the source code that is relevant to an issue,
+// but not where the issue itself is. e.g: the first declaration of a duplicate key.
+type RelatedLocation struct {
+	Message string
+	Range   sitter.Range
+}
+
 type Issue struct {
 	// The message to display to the user
 	Message string
+	// Severity of the issue. Defaults to `SeverityWarning`.
+	Severity Severity
+	// RuleName is the name of the rule that raised this issue.
+	// Set by the analyzer when the issue is reported.
+	RuleName string
 	// The range of the issue in the source code
 	Range sitter.Range
 	// (optional) The AST node that caused the issue
@@ -14,6 +53,42 @@ type Issue struct {
 	// Id is a unique ID for the issue.
 	// Issue that have 'Id's can be explained using the `one desc` command.
 	Id *string
+	// (optional) Related is a list of other locations that are relevant to the issue
+	Related []RelatedLocation
+	// (optional) Fix is an edit that resolves the issue.
+	// Fixes can be applied with `ApplyFixes`.
+	Fix *Fix
+	// (optional) Snippet is the source text that triggered the issue.
+	// Only set when `Analyzer.IncludeSnippets` is enabled.
+	Snippet string
+	// (optional) Metadata holds extra information about the issue that
+	// reporters may use, like a CWE id or a link to the documentation.
+	// See the `Metadata*` constants for the keys that are commonly used.
+	Metadata map[string]string
+}
+
+// Well-known keys for `Issue.Metadata`.
+const (
+	// MetadataCWE is the id of the weakness in the Common Weakness Enumeration, like "CWE-95".
+	MetadataCWE = "cwe"
+	// MetadataDocsURL is a link to the documentation of the rule.
+	// Set by the analyzer for rules that implement `DocumentedRule`.
+	MetadataDocsURL = "docs-url"
+	// MetadataFixConfidence is how likely the fix is to be correct: "high", "medium", or "low".
+	MetadataFixConfidence = "fix-confidence"
+)
+
+// PromoteWarningsToErrors raises the severity of all warnings in `issues` to errors.
+// Issues that are already errors, or are merely informational, are left untouched.
+// The issues are modified in place, and the same slice is returned for convenience.
+func PromoteWarningsToErrors(issues []*Issue) []*Issue {
+	for _, issue := range issues {
+		if issue.Severity == SeverityWarning {
+			issue.Severity = SeverityError
+		}
+	}
+
+	return issues
 }
 
 type Analyzer struct {
@@ -24,16 +99,57 @@ type Analyzer struct {
 	// rules is a list of all rules that should be applied to the AST
 	// for this language.
 	rules []Rule
+	// disabledRules is the set of names of rules that are registered,
+	// but should not be run.
+	disabledRules map[string]bool
 	// patternRules is a list of all rules that run after a query is run on the AST.
 	// Usually, these are written in a DSL (which, for now, is the tree-sitter S-Expression query language)
 	PatternRules []PatternRule
+	// VisitAnonymousNodes makes the analyzer walk anonymous nodes too (see `WalkAll`),
+	// for rules that register for keywords or operators.
+	// By default, only named nodes are visited, which is a lot fewer callbacks.
+	VisitAnonymousNodes bool
+	// IncludeSnippets makes `Report` fill in the `Snippet` of every issue
+	// with the source text of its node (or range).
+	IncludeSnippets bool
+	// ReportUnusedSuppressions makes the analyzer report every `onelint-disable-*` comment
+	// that didn't suppress any issues, so that stale ones can be cleaned up.
+	// Rules listed in a comment are only checked if they're enabled in this analyzer.
+	ReportUnusedSuppressions bool
+	// MaxSnippetLength is the maximum length of a snippet in bytes,
+	// after which it is truncated. Defaults to 200 when 0.
+	MaxSnippetLength int
+	// MaxIssues is the number of issues after which the analyzer stops walking the file,
+	// and reports a final `IssueLimitRuleName` issue to say that more issues may exist.
+	// Keeps the output bounded on badly broken files. Unlimited when 0.
+	MaxIssues int
 	// entryRules maps node types to the rules that should be applied
 	// when entering that node.
 	entryRulesForNode map[string][]Rule
 	// exitRules maps node types to the rules that should be applied
 	// when leaving that node.
 	exitRulesForNode map[string][]Rule
-	issuesRaised     []*Issue
+	// issuesRaised are the issues reported in the current analysis run.
+	issuesRaised []*Issue
+	// numIssues is the number of issues reported in the current analysis run.
+	numIssues int
+	// limitReached is set once `MaxIssues` issues have been reported,
+	// after which no more rules are run.
+	limitReached bool
+	// onIssue, when set, is called for every issue as soon as it's reported,
+	// instead of buffering it in `issuesRaised`.
+	onIssue func(*Issue)
+	// facts is a key-value store that rules can use to share
+	// information with each other during an analysis run.
+	facts map[string]any
+	// currentRule is the rule that is being invoked right now (if any)
+	currentRule Rule
+	// interesting tells, for every symbol in the grammar, whether
+	// any rules are registered for nodes of that type.
+	interesting []bool
+	// suppressions are the `onelint-disable-*` comments in the file.
+	// Issues that they match are dropped when reported.
+	suppressions []*Suppression
 }
 
 func FromFile(filePath string, baseRules []Rule) (*Analyzer, error) {
@@ -53,59 +169,212 @@ func NewAnalyzer(file *ParseResult, rules []Rule) *Analyzer {
 		exitRulesForNode:  map[string][]Rule{},
 	}
 
-	for _, rule := range rules {
+	for _, rule := range sortByDependencies(rules) {
 		ana.AddRule(rule)
 	}
 
 	return ana
 }
 
+// FileNodeType is a pseudo node-type that rules can register for
+// to be invoked once per file with the root node of the AST.
+// The `OnEnter` callback runs before any other node is visited, and
+// `OnLeave` runs after the entire tree has been walked.
+// Useful for rules that inspect the source text as a whole (e.g: formatting rules),
+// or ones that report after collecting information from the entire file.
+const FileNodeType = "<file>"
+
 func (ana *Analyzer) Analyze() []*Issue {
-	WalkTree(ana.ParseResult.Ast, ana)
-	ana.runPatternRules()
+	ana.analyze()
 	return ana.issuesRaised
 }
 
+// AnalyzeStream is like Analyze, but instead of collecting all issues
+// and returning them at the end, it invokes `onIssue` for every issue
+// as soon as it is reported.
+func (ana *Analyzer) AnalyzeStream(onIssue func(*Issue)) {
+	ana.onIssue = onIssue
+	defer func() { ana.onIssue = nil }()
+	ana.analyze()
+}
+
+func (ana *Analyzer) analyze() {
+	ana.facts = map[string]any{}
+	ana.issuesRaised = nil
+	ana.suppressions = ana.ParseResult.Suppressions()
+	ana.numIssues = 0
+	ana.limitReached = false
+
+	root := ana.ParseResult.Ast
+	ana.runEntryRules(ana.entryRulesForNode[FileNodeType], root)
+	ana.walk(root)
+	ana.runExitRules(ana.exitRulesForNode[FileNodeType], root)
+	ana.runPatternRules()
+
+	// suppressions look unused when the analysis was cut short.
+	if ana.ReportUnusedSuppressions && !ana.limitReached {
+		ana.reportUnusedSuppressions()
+	}
+}
+
+// AddRule registers a rule with the analyzer.
+// Rules added this way run after all the rules that were passed to `NewAnalyzer`,
+// regardless of their dependencies.
 func (ana *Analyzer) AddRule(rule Rule) {
 	ana.rules = append(ana.rules, rule)
-	typ := rule.NodeType()
+	if !ana.disabledRules[rule.Name()] {
+		ana.registerRule(rule)
+	}
+}
+
+// registerRule adds a rule to the maps that are used to dispatch rules during a traversal
+func (ana *Analyzer) registerRule(rule Rule) {
+	for _, typ := range nodeTypesOfRule(rule) {
+		if rule.OnEnter() != nil {
+			ana.entryRulesForNode[typ] = append(ana.entryRulesForNode[typ], rule)
+		}
+
+		if rule.OnLeave() != nil {
+			ana.exitRulesForNode[typ] = append(ana.exitRulesForNode[typ], rule)
+		}
+	}
+}
+
+// EnableOnly disables all registered rules except the ones named in `names`.
+func (ana *Analyzer) EnableOnly(names ...string) {
+	ana.disabledRules = map[string]bool{}
+	for _, rule := range ana.rules {
+		if !slices.Contains(names, rule.Name()) {
+			ana.disabledRules[rule.Name()] = true
+		}
+	}
+
+	ana.rebuildRuleMaps()
+}
+
+// Disable stops the rules named in `names` from running.
+// The rules stay disabled even if they are added again with `AddRule`.
+func (ana *Analyzer) Disable(names ...string) {
+	if ana.disabledRules == nil {
+		ana.disabledRules = map[string]bool{}
+	}
+
+	for _, name := range names {
+		ana.disabledRules[name] = true
+	}
+
+	ana.rebuildRuleMaps()
+}
 
-	if rule.OnEnter() != nil {
-		ana.entryRulesForNode[typ] = append(ana.entryRulesForNode[typ], rule)
+func (ana *Analyzer) rebuildRuleMaps() {
+	ana.entryRulesForNode = map[string][]Rule{}
+	ana.exitRulesForNode = map[string][]Rule{}
+	for _, rule := range ana.rules {
+		if !ana.disabledRules[rule.Name()] {
+			ana.registerRule(rule)
+		}
 	}
+}
 
-	if rule.OnLeave() != nil {
-		ana.exitRulesForNode[typ] = append(ana.exitRulesForNode[typ], rule)
+// interestingSymbols returns a table that tells, for every grammar symbol,
+// whether any rule is registered for nodes of that type.
+// Returns nil if the grammar is unknown.
+func (ana *Analyzer) interestingSymbols() []bool {
+	grammar := ana.ParseResult.TsLanguage
+	if grammar == nil {
+		return nil
 	}
+
+	interesting := make([]bool, grammar.SymbolCount())
+	for i := range interesting {
+		name := grammar.SymbolName(sitter.Symbol(i))
+		interesting[i] = len(ana.entryRulesForNode[name]) > 0 || len(ana.exitRulesForNode[name]) > 0
+	}
+
+	return interesting
+}
+
+// walk traverses the tree rooted at `root`, and invokes
+// the rules registered for every node it visits.
+func (ana *Analyzer) walk(root *sitter.Node) {
+	ana.interesting = ana.interestingSymbols()
+	walkWithCursor(root, ana, ana.VisitAnonymousNodes)
+}
+
+// hasRules returns true if any rule might be registered for the type of `node`.
+// Checking the symbol table avoids looking up the rules for node types that have none.
+func (ana *Analyzer) hasRules(node *sitter.Node) bool {
+	if ana.interesting == nil {
+		return true
+	}
+
+	symbol := int(node.Symbol())
+	return symbol < len(ana.interesting) && ana.interesting[symbol]
 }
 
 func (ana *Analyzer) OnEnterNode(node *sitter.Node) bool {
-	nodeType := node.Type()
-	rules := ana.entryRulesForNode[nodeType]
+	if ana.hasRules(node) {
+		ana.runEntryRules(ana.entryRulesForNode[node.Type()], node)
+	}
+
+	// once the issue limit is reached, there's no point in walking the rest of the tree.
+	return !ana.limitReached
+}
+
+func (ana *Analyzer) OnLeaveNode(node *sitter.Node) {
+	if ana.hasRules(node) {
+		ana.runExitRules(ana.exitRulesForNode[node.Type()], node)
+	}
+}
+
+func (ana *Analyzer) runEntryRules(rules []Rule, node *sitter.Node) {
+	if ana.limitReached {
+		return
+	}
+
 	for _, rule := range rules {
 		visitFn := rule.OnEnter()
 		if visitFn != nil {
+			ana.currentRule = rule
 			(*visitFn)(rule, ana, node)
 		}
 	}
-	return true
+
+	ana.currentRule = nil
 }
 
-func (ana *Analyzer) OnLeaveNode(node *sitter.Node) {
-	nodeType := node.Type()
-	rules := ana.exitRulesForNode[nodeType]
+func (ana *Analyzer) runExitRules(rules []Rule, node *sitter.Node) {
+	if ana.limitReached {
+		return
+	}
+
 	for _, rule := range rules {
 		visitFn := rule.OnLeave()
 		if visitFn != nil {
+			ana.currentRule = rule
 			(*visitFn)(rule, ana, node)
 		}
 	}
+
+	ana.currentRule = nil
 }
 
 // runPatternRules executes all rules that are written as AST queries.
 func (ana *Analyzer) runPatternRules() {
 	for _, rule := range ana.PatternRules {
+		if ana.limitReached {
+			return
+		}
+
 		query := rule.Pattern()
+		if rule, ok := rule.(grammarPattern); ok {
+			query = rule.PatternFor(ana.ParseResult.TsLanguage)
+		}
+
+		if query == nil {
+			continue
+		}
+
 		qc := sitter.NewQueryCursor()
 		defer qc.Close()
 
@@ -118,6 +387,10 @@ func (ana *Analyzer) runPatternRules() {
 			}
 
 			for _, capture := range m.Captures {
+				if ana.limitReached {
+					break
+				}
+
 				rule.OnMatch(ana, capture.Node)
 			}
 		}
@@ -125,5 +398,172 @@ func (ana *Analyzer) runPatternRules() {
 }
 
 func (ana *Analyzer) Report(issue *Issue) {
+	if issue.RuleName == "" && ana.currentRule != nil {
+		issue.RuleName = ana.currentRule.Name()
+	}
+
+	if ana.isSuppressed(issue) {
+		return
+	}
+
+	ana.emit(issue)
+}
+
+// emit fills in the details of an issue that has been reported, and hands it over
+// to the caller (or buffers it, see `AnalyzeStream`).
+func (ana *Analyzer) emit(issue *Issue) {
+	if ana.limitReached {
+		return
+	}
+
+	if ana.currentRule != nil && issue.Metadata[MetadataDocsURL] == "" {
+		if url := DocURLOf(ana.currentRule); url != "" {
+			if issue.Metadata == nil {
+				issue.Metadata = map[string]string{}
+			}
+			issue.Metadata[MetadataDocsURL] = url
+		}
+	}
+
+	if ana.IncludeSnippets && issue.Snippet == "" {
+		issue.Snippet = ana.snippetOf(issue)
+	}
+
+	ana.deliver(issue)
+
+	ana.numIssues++
+	if ana.MaxIssues > 0 && ana.numIssues >= ana.MaxIssues {
+		ana.limitReached = true
+		ana.deliver(&Issue{
+			Message:  fmt.Sprintf("Stopped analyzing after %d issues, more issues may exist in this file", ana.numIssues),
+			Severity: SeverityInfo,
+			RuleName: IssueLimitRuleName,
+			Range:    issue.Range,
+		})
+	}
+}
+
+// IssueLimitRuleName is the `RuleName` of the issue reported
+// when an analysis stops early because of `Analyzer.MaxIssues`.
+const IssueLimitRuleName = "issue-limit"
+
+// deliver hands an issue over to the caller, or buffers it (see `AnalyzeStream`).
+func (ana *Analyzer) deliver(issue *Issue) {
+	if ana.onIssue != nil {
+		ana.onIssue(issue)
+		return
+	}
+
 	ana.issuesRaised = append(ana.issuesRaised, issue)
 }
+
+const defaultMaxSnippetLength = 200
+
+// snippetOf returns the source text of the node (or the range) that `issue` is about,
+// truncated to `MaxSnippetLength` bytes.
+func (ana *Analyzer) snippetOf(issue *Issue) string {
+	source := ana.ParseResult.Source
+	start, end := issue.Range.StartByte, issue.Range.EndByte
+	if issue.Node != nil {
+		start, end = issue.Node.StartByte(), issue.Node.EndByte()
+	}
+
+	if start > end || int(end) > len(source) {
+		return ""
+	}
+
+	maxLength := ana.MaxSnippetLength
+	if maxLength == 0 {
+		maxLength = defaultMaxSnippetLength
+	}
+
+	snippet := source[start:end]
+	if len(snippet) <= maxLength {
+		return string(snippet)
+	}
+
+	// don't cut a multi-byte character in half.
+	cut := maxLength
+	for cut > 0 && !utf8.RuneStart(snippet[cut]) {
+		cut--
+	}
+
+	return string(snippet[:cut]) + "..."
+}
+
+// isSuppressed returns true if a suppression comment silences `issue`.
+// Every suppression that matches `issue` is marked as used.
+func (ana *Analyzer) isSuppressed(issue *Issue) bool {
+	suppressed := false
+	for _, suppression := range ana.suppressions {
+		if suppression.suppresses(issue) {
+			suppression.markUsed(issue.RuleName)
+			suppressed = true
+		}
+	}
+
+	return suppressed
+}
+
+// isEnabled returns true if a rule named `name` is registered and not disabled.
+func (ana *Analyzer) isEnabled(name string) bool {
+	if ana.disabledRules[name] {
+		return false
+	}
+
+	return slices.ContainsFunc(ana.rules, func(rule Rule) bool { return rule.Name() == name })
+}
+
+// reportUnusedSuppressions reports the suppression comments that didn't suppress any issues.
+func (ana *Analyzer) reportUnusedSuppressions() {
+	for _, suppression := range ana.suppressions {
+		message := ""
+		if len(suppression.Rules) == 0 {
+			if len(suppression.used) == 0 {
+				message = "Unused suppression comment: no issues were suppressed"
+			}
+		} else {
+			var unused []string
+			for _, rule := range suppression.Rules {
+				if !suppression.used[rule] && ana.isEnabled(rule) {
+					unused = append(unused, fmt.Sprintf("'%s'", rule))
+				}
+			}
+
+			if len(unused) > 0 {
+				message = fmt.Sprintf("Unused suppression comment: no issues from %s were suppressed", strings.Join(unused, ", "))
+			}
+		}
+
+		if message == "" {
+			continue
+		}
+
+		// these issues are about the suppression comments themselves,
+		// so they can't be suppressed.
+		ana.emit(&Issue{
+			Message:  message,
+			RuleName: UnusedSuppressionRuleName,
+			Range:    suppression.Comment.Range(),
+			Node:     suppression.Comment,
+		})
+	}
+}
+
+// Set stores a value that other rules can read with `Get` during the current analysis run.
+// To avoid collisions, keys should be prefixed with the name of the rule that sets them.
+// e.g: "js-exports/names".
+func (ana *Analyzer) Set(key string, value any) {
+	if ana.facts == nil {
+		ana.facts = map[string]any{}
+	}
+
+	ana.facts[key] = value
+}
+
+// Get returns a value stored with `Set` during the current analysis run.
+// The store is cleared at the start of every run.
+func (ana *Analyzer) Get(key string) (any, bool) {
+	value, ok := ana.facts[key]
+	return value, ok
+}
@@ -0,0 +1,260 @@
+package one
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// reportAll creates a rule that reports every node of a type
+func reportAll(nodeType string) Rule {
+	var entry VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) {
+		ana.Report(&Issue{
+			Message: node.Content(ana.ParseResult.Source),
+			Range:   node.Range(),
+		})
+	}
+
+	return CreateRule("report-all-"+nodeType, nodeType, LangJs, &entry, nil)
+}
+
+func Test_AnalyzeStream(t *testing.T) {
+	parsed := parseFile(t, "a; b; c")
+	analyzer := NewAnalyzer(parsed, []Rule{reportAll("identifier")})
+
+	var streamed []string
+	analyzer.AnalyzeStream(func(issue *Issue) {
+		streamed = append(streamed, issue.Message)
+	})
+
+	assert.Equal(t, []string{"a", "b", "c"}, streamed)
+	assert.Empty(t, analyzer.issuesRaised, "streamed issues should not be buffered")
+}
+
+func Test_IncludeSnippets(t *testing.T) {
+	parsed := parseFile(t, `short(); aVeryLongFunctionName("héllo")`)
+	rule := reportAll("call_expression")
+
+	issues := NewAnalyzer(parsed, []Rule{rule}).Analyze()
+	require.Equal(t, 2, len(issues))
+	assert.Empty(t, issues[0].Snippet)
+
+	analyzer := NewAnalyzer(parsed, []Rule{rule})
+	analyzer.IncludeSnippets = true
+	analyzer.MaxSnippetLength = 25
+	issues = analyzer.Analyze()
+	require.Equal(t, 2, len(issues))
+	assert.Equal(t, "short()", issues[0].Snippet)
+	// the snippet is cut before the 'é', which is two bytes long.
+	assert.Equal(t, `aVeryLongFunctionName("h...`, issues[1].Snippet)
+}
+
+func Test_RuleDependencies(t *testing.T) {
+	var order []string
+	record := func(name string, deps ...string) Rule {
+		var entry VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) {
+			order = append(order, r.Name())
+		}
+
+		rule := CreateRule(name, "program", LangJs, &entry, nil)
+		if len(deps) > 0 {
+			return WithDependencies(rule, deps...)
+		}
+		return rule
+	}
+
+	parsed := parseFile(t, "x")
+	rules := []Rule{
+		record("c", "b"),
+		record("a"),
+		record("b", "a", "missing"),
+		record("d"),
+	}
+
+	NewAnalyzer(parsed, rules).Analyze()
+	assert.Equal(t, []string{"a", "b", "c", "d"}, order)
+
+	// cycles don't cause an infinite loop, and all rules still run
+	order = nil
+	NewAnalyzer(parsed, []Rule{record("x", "y"), record("y", "x")}).Analyze()
+	assert.Equal(t, []string{"y", "x"}, order)
+
+	// rules don't have to be comparable
+	order = nil
+	NewAnalyzer(parsed, []Rule{uncomparableRule{Rule: record("e")}, record("f")}).Analyze()
+	assert.Equal(t, []string{"e", "f"}, order)
+}
+
+// uncomparableRule is a Rule that can't be used as a map key.
+type uncomparableRule struct {
+	Rule
+	tags []string
+}
+
+func Test_RuleDocURL(t *testing.T) {
+	parsed := parseFile(t, "a")
+	rule := reportAll("identifier")
+	assert.Empty(t, DocURLOf(rule))
+
+	issues := NewAnalyzer(parsed, []Rule{rule}).Analyze()
+	require.Len(t, issues, 1)
+	assert.Empty(t, issues[0].Metadata[MetadataDocsURL])
+
+	documented := WithDocURL(WithDependencies(rule, "other"), "https://example.com/report-all")
+	assert.Equal(t, "https://example.com/report-all", DocURLOf(documented))
+	assert.Equal(t, []string{"other"}, documented.(DependentRule).Dependencies())
+
+	issues = NewAnalyzer(parsed, []Rule{documented}).Analyze()
+	require.Len(t, issues, 1)
+	assert.Equal(t, "https://example.com/report-all", issues[0].Metadata[MetadataDocsURL])
+
+	// wrapping a documented rule keeps the link
+	issues = NewAnalyzer(parsed, []Rule{WithDependencies(documented, "other")}).Analyze()
+	require.Len(t, issues, 1)
+	assert.Equal(t, "https://example.com/report-all", issues[0].Metadata[MetadataDocsURL])
+}
+
+func Test_MaxIssues(t *testing.T) {
+	parsed := parseFile(t, "a; b; c; d; e")
+	visited := 0
+	var count VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) { visited++ }
+	rules := []Rule{reportAll("identifier"), CreateRule("count", "expression_statement", LangJs, &count, nil)}
+
+	analyzer := NewAnalyzer(parsed, rules)
+	analyzer.MaxIssues = 2
+	issues := analyzer.Analyze()
+	require.Len(t, issues, 3)
+	assert.Equal(t, "a", issues[0].Message)
+	assert.Equal(t, "b", issues[1].Message)
+	assert.Equal(t, IssueLimitRuleName, issues[2].RuleName)
+	assert.Equal(t, "Stopped analyzing after 2 issues, more issues may exist in this file", issues[2].Message)
+	assert.Equal(t, SeverityInfo, issues[2].Severity)
+	// the walk stops as soon as the limit is reached
+	assert.Equal(t, 2, visited)
+
+	// the limit is per analysis run
+	var streamed []string
+	analyzer.AnalyzeStream(func(issue *Issue) { streamed = append(streamed, issue.RuleName) })
+	assert.Equal(t, []string{"report-all-identifier", "report-all-identifier", IssueLimitRuleName}, streamed)
+
+	analyzer = NewAnalyzer(parsed, rules)
+	assert.Len(t, analyzer.Analyze(), 5)
+}
+
+func Test_SharedFacts(t *testing.T) {
+	var countIds VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) {
+		count, _ := ana.Get("count-ids/count")
+		n, _ := count.(int)
+		ana.Set("count-ids/count", n+1)
+	}
+
+	var reportCount VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) {
+		count, ok := ana.Get("count-ids/count")
+		assert.True(t, ok)
+		ana.Report(&Issue{Message: fmt.Sprintf("%d identifiers", count), Range: node.Range()})
+	}
+
+	rules := []Rule{
+		CreateRule("report-count", FileNodeType, LangJs, nil, &reportCount),
+		CreateRule("count-ids", "identifier", LangJs, &countIds, nil),
+	}
+
+	analyzer := NewAnalyzer(parseFile(t, "a + b + c"), rules)
+	issues := analyzer.Analyze()
+	assert.Equal(t, 1, len(issues))
+	assert.Equal(t, "3 identifiers", issues[0].Message)
+
+	// neither facts nor issues leak across analysis runs
+	issues = analyzer.Analyze()
+	require.Len(t, issues, 1)
+	assert.Equal(t, "3 identifiers", issues[0].Message)
+}
+
+func Test_PromoteWarningsToErrors(t *testing.T) {
+	issues := []*Issue{
+		{Message: "default"},
+		{Message: "warning", Severity: SeverityWarning},
+		{Message: "error", Severity: SeverityError},
+		{Message: "info", Severity: SeverityInfo},
+	}
+
+	promoted := PromoteWarningsToErrors(issues)
+	assert.Equal(t, SeverityError, promoted[0].Severity)
+	assert.Equal(t, SeverityError, promoted[1].Severity)
+	assert.Equal(t, SeverityError, promoted[2].Severity)
+	assert.Equal(t, SeverityInfo, promoted[3].Severity)
+}
+
+func Test_EnableOnlyAndDisable(t *testing.T) {
+	parsed := parseFile(t, "let a = 1")
+	rulesRun := func(ana *Analyzer) []string {
+		var names []string
+		for _, issue := range ana.Analyze() {
+			names = append(names, issue.RuleName)
+		}
+		return names
+	}
+
+	rules := []Rule{reportAll("identifier"), reportAll("number"), reportAll("program")}
+
+	analyzer := NewAnalyzer(parsed, rules)
+	analyzer.EnableOnly("report-all-number", "report-all-program")
+	assert.Equal(t, []string{"report-all-program", "report-all-number"}, rulesRun(analyzer))
+
+	analyzer = NewAnalyzer(parsed, rules)
+	analyzer.Disable("report-all-program")
+	analyzer.AddRule(reportAll("program"))
+	assert.Equal(t, []string{"report-all-identifier", "report-all-number"}, rulesRun(analyzer))
+
+	analyzer = NewAnalyzer(parsed, rules)
+	analyzer.Disable("report-all-program")
+	analyzer.EnableOnly("report-all-program")
+	assert.Equal(t, []string{"report-all-program"}, rulesRun(analyzer))
+}
+
+// traceWalker records the order in which a walk enters and leaves nodes.
+type traceWalker struct{ trace []string }
+
+func (w *traceWalker) OnEnterNode(node *sitter.Node) bool {
+	w.trace = append(w.trace, "enter "+node.Type())
+	return true
+}
+
+func (w *traceWalker) OnLeaveNode(node *sitter.Node) {
+	w.trace = append(w.trace, "leave "+node.Type())
+}
+
+func Test_AnalyzerWalkOrder(t *testing.T) {
+	parsed := parseFile(t, `function f(a) { if (a) { return [a, 1] } }`)
+
+	expected := &traceWalker{}
+	WalkTree(parsed.Ast, expected)
+
+	var types []string
+	for _, entry := range expected.trace {
+		if typ := entry[len("enter "):]; entry[:len("enter ")] == "enter " && !slices.Contains(types, typ) {
+			types = append(types, typ)
+		}
+	}
+
+	got := &traceWalker{}
+	var entry VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) { got.OnEnterNode(node) }
+	var exit VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) { got.OnLeaveNode(node) }
+	NewAnalyzer(parsed, []Rule{CreateMultiNodeRule("trace", types, LangJs, &entry, &exit)}).Analyze()
+
+	assert.Equal(t, expected.trace, got.trace)
+}
+
+func BenchmarkAnalyze(b *testing.B) {
+	parsed, err := ParseString(walkBenchSource, LangJs)
+	require.NoError(b, err)
+
+	analyzer := NewAnalyzer(parsed, []Rule{reportAll("call_expression")})
+	for i := 0; i < b.N; i++ {
+		analyzer.AnalyzeStream(func(*Issue) {})
+	}
+}
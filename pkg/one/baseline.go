@@ -0,0 +1,139 @@
+package one
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// A Baseline is a record of known issues in a project.
+// Issues in the baseline are suppressed in subsequent runs,
+// so that only newly introduced issues are surfaced.
+// Useful for adopting the linter in an existing codebase.
+type Baseline struct {
+	Issues []BaselineEntry `json:"issues"`
+}
+
+type BaselineEntry struct {
+	// Rule is the name of the rule that raised the issue
+	Rule string `json:"rule"`
+	// File is the (slash separated) path of the file in which the issue was raised
+	File string `json:"file"`
+	// Fingerprint identifies the issue based on the source text around it.
+	// Unlike line numbers, it doesn't change when unrelated lines are added or removed.
+	Fingerprint string `json:"fingerprint"`
+}
+
+// IssueFingerprint returns a hash that identifies an issue by its rule, message,
+// and the source text of the line(s) it was raised on.
+// `source` is the content of the file in which the issue was raised.
+func IssueFingerprint(issue *Issue, source []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(issue.RuleName))
+	hash.Write([]byte{0})
+	hash.Write([]byte(issue.Message))
+	hash.Write([]byte{0})
+
+	lines := bytes.Split(source, []byte("\n"))
+	start, end := int(issue.Range.StartPoint.Row), int(issue.Range.EndPoint.Row)
+	for row := start; row <= end && row < len(lines); row++ {
+		hash.Write(bytes.TrimSpace(lines[row]))
+		hash.Write([]byte{'\n'})
+	}
+
+	// the flagged snippet itself, to tell apart different issues on the same line
+	if int(issue.Range.EndByte) <= len(source) && issue.Range.StartByte <= issue.Range.EndByte {
+		hash.Write(source[issue.Range.StartByte:issue.Range.EndByte])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// baselineKey is used to look up issues in a baseline
+type baselineKey BaselineEntry
+
+// fingerprintAll computes the baseline keys for all issues in a file.
+// The file is read from disk, and if that fails, only the rule and message are used.
+func fingerprintAll(path string, issues []*Issue) []baselineKey {
+	source, _ := os.ReadFile(path)
+	keys := make([]baselineKey, 0, len(issues))
+	for _, issue := range issues {
+		keys = append(keys, baselineKey{
+			Rule:        issue.RuleName,
+			File:        filepath.ToSlash(path),
+			Fingerprint: IssueFingerprint(issue, source),
+		})
+	}
+
+	return keys
+}
+
+// NewBaseline creates a baseline from the results of an analysis
+// (a map of file-paths to issues raised in that file).
+func NewBaseline(results map[string][]*Issue) *Baseline {
+	baseline := &Baseline{Issues: []BaselineEntry{}}
+	for path, issues := range results {
+		for _, key := range fingerprintAll(path, issues) {
+			baseline.Issues = append(baseline.Issues, BaselineEntry(key))
+		}
+	}
+
+	sort.Slice(baseline.Issues, func(i, j int) bool {
+		a, b := baseline.Issues[i], baseline.Issues[j]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		if a.Rule != b.Rule {
+			return a.Rule < b.Rule
+		}
+		return a.Fingerprint < b.Fingerprint
+	})
+
+	return baseline
+}
+
+// WriteBaseline writes a baseline for `results` (a map of file-paths to issues) to `w` as JSON.
+func WriteBaseline(results map[string][]*Issue, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(NewBaseline(results))
+}
+
+// ReadBaseline reads a baseline written by `WriteBaseline`.
+func ReadBaseline(r io.Reader) (*Baseline, error) {
+	var baseline Baseline
+	if err := json.NewDecoder(r).Decode(&baseline); err != nil {
+		return nil, err
+	}
+
+	return &baseline, nil
+}
+
+// FilterAgainstBaseline returns the issues in `results` that are not in the baseline.
+// If an issue occurs N times in the baseline, only its first N occurrences are suppressed.
+// Files that have no issues left are dropped from the result.
+func FilterAgainstBaseline(results map[string][]*Issue, baseline *Baseline) map[string][]*Issue {
+	known := map[baselineKey]int{}
+	for _, entry := range baseline.Issues {
+		known[baselineKey(entry)]++
+	}
+
+	filtered := map[string][]*Issue{}
+	for path, issues := range results {
+		for i, key := range fingerprintAll(path, issues) {
+			if known[key] > 0 {
+				known[key]--
+				continue
+			}
+
+			filtered[path] = append(filtered[path], issues[i])
+		}
+	}
+
+	return filtered
+}
@@ -0,0 +1,50 @@
+package one
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func analyzeFile(t *testing.T, path string, rules []Rule) []*Issue {
+	analyzer, err := FromFile(path, rules)
+	require.NoError(t, err)
+	return analyzer.Analyze()
+}
+
+func Test_Baseline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.js")
+	rules := []Rule{reportAll("identifier")}
+
+	require.NoError(t, os.WriteFile(path, []byte("a\nb\na\n"), 0o644))
+	results := map[string][]*Issue{path: analyzeFile(t, path, rules)}
+	require.Equal(t, 3, len(results[path]))
+	assert.Equal(t, "report-all-identifier", results[path][0].RuleName)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteBaseline(results, &buf))
+
+	baseline, err := ReadBaseline(&buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(baseline.Issues))
+
+	// shifting lines around doesn't bring back old issues,
+	// but new ones (including extra copies of old ones) are reported.
+	require.NoError(t, os.WriteFile(path, []byte("\n\nb\na\nc\na\na\n"), 0o644))
+	results = map[string][]*Issue{path: analyzeFile(t, path, rules)}
+	filtered := FilterAgainstBaseline(results, baseline)
+
+	require.Equal(t, 2, len(filtered[path]))
+	assert.Equal(t, "c", filtered[path][0].Message)
+	assert.Equal(t, "a", filtered[path][1].Message)
+	assert.Equal(t, uint32(6), filtered[path][1].Range.StartPoint.Row)
+
+	// files with no new issues are dropped
+	require.NoError(t, os.WriteFile(path, []byte("b\na\n"), 0o644))
+	results = map[string][]*Issue{path: analyzeFile(t, path, rules)}
+	assert.Empty(t, FilterAgainstBaseline(results, baseline))
+}
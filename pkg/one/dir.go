@@ -0,0 +1,141 @@
+package one
+
+import (
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// DefaultIgnoreDirs are the names of directories that `AnalyzeDirStream`
+// and the `one lint` command never descend into.
+// These usually contain dependencies, build output, or version control data.
+var DefaultIgnoreDirs = []string{
+	"node_modules",
+	"vendor",
+	"dist",
+	"build",
+	"out",
+	".git",
+	".svn",
+	"venv",
+	"__pycache__",
+	".idea",
+}
+
+// HasAllowedExtension returns true if `path` has one of the `extensions`,
+// which may be written with or without a leading dot (".ts" or "ts").
+// When `extensions` is empty, every path is allowed.
+func HasAllowedExtension(path string, extensions []string) bool {
+	if len(extensions) == 0 {
+		return true
+	}
+
+	ext := filepath.Ext(path)
+	for _, allowed := range extensions {
+		if ext == "."+strings.TrimPrefix(allowed, ".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FileDoneFn is called by `AnalyzeDirStream` once a file has been analyzed,
+// with the issues found in it, or the error that stopped it from being analyzed.
+type FileDoneFn func(path string, issues []*Issue, err error)
+
+// AnalyzeDir analyzes every file under `root` written in a language that has rules in `rules`,
+// with at most `concurrency` files being analyzed at once (the number of CPUs when `concurrency` <= 0).
+// When `extensions` is not empty, only files with one of those extensions are analyzed.
+// Directories in `DefaultIgnoreDirs` are skipped.
+// It returns the issues found in every file, and the errors for files that couldn't be analyzed,
+// both keyed by path.
+func AnalyzeDir(
+	root string,
+	rules map[Language][]Rule,
+	extensions []string,
+	concurrency int,
+) (map[string][]*Issue, map[string]error, error) {
+	results := map[string][]*Issue{}
+	errs := map[string]error{}
+	err := AnalyzeDirStream(root, rules, extensions, concurrency, func(path string, issues []*Issue, err error) {
+		if err != nil {
+			errs[path] = err
+		} else {
+			results[path] = issues
+		}
+	})
+
+	return results, errs, err
+}
+
+// AnalyzeDirStream is like `AnalyzeDir`, but calls `onFileDone` as soon as each file is analyzed,
+// so callers can report progress or stream results on large codebases.
+// Calls to `onFileDone` are serialized, so it doesn't need to be safe for concurrent use.
+// The returned error is only for failures to walk `root`. Errors in individual files
+// are passed to `onFileDone` instead.
+func AnalyzeDirStream(
+	root string,
+	rules map[Language][]Rule,
+	extensions []string,
+	concurrency int,
+	onFileDone FileDoneFn,
+) error {
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	// every file holds a slot in `slots` while it is being analyzed.
+	slots := make(chan struct{}, concurrency)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			if slices.Contains(DefaultIgnoreDirs, d.Name()) {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if d.Type()&fs.ModeSymlink != 0 || !HasAllowedExtension(path, extensions) {
+			return nil
+		}
+
+		langRules := rules[LanguageFromFilePath(path)]
+		if len(langRules) == 0 {
+			return nil
+		}
+
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			var issues []*Issue
+			analyzer, err := FromFile(path, langRules)
+			if err == nil {
+				issues = analyzer.Analyze()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			onFileDone(path, issues, err)
+		}()
+
+		return nil
+	})
+
+	wg.Wait()
+	return err
+}
@@ -0,0 +1,69 @@
+package one
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AnalyzeDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.js":       "a; b",
+		"sub/b.js":   "c",
+		"sub/c.py":   "d = 1",
+		"notes.txt":  "e",
+		"sub/d/e.js": "",
+		// dependencies and build output are skipped, like `one lint` does.
+		"node_modules/dep/index.js": "f",
+		"sub/dist/bundle.js":        "g",
+		".git/hooks/hook.js":        "h",
+	}
+
+	for name, source := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(source), 0o644))
+	}
+
+	rules := map[Language][]Rule{LangJs: {reportAll("identifier")}}
+	results, errs, err := AnalyzeDir(dir, rules, nil, 2)
+	require.NoError(t, err)
+	assert.Empty(t, errs)
+	require.Len(t, results, 3)
+	assert.Len(t, results[filepath.Join(dir, "a.js")], 2)
+	assert.Len(t, results[filepath.Join(dir, "sub/b.js")], 1)
+	assert.Empty(t, results[filepath.Join(dir, "sub/d/e.js")])
+
+	// the callback is never called concurrently
+	var running, calls int32
+	err = AnalyzeDirStream(dir, rules, nil, 0, func(path string, issues []*Issue, err error) {
+		assert.Equal(t, int32(1), atomic.AddInt32(&running, 1))
+		defer atomic.AddInt32(&running, -1)
+		calls++
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), calls)
+
+	// only files with the given extensions are analyzed, even if there are rules for other languages.
+	rules[LangPy] = []Rule{reportAll("identifier")}
+	results, _, err = AnalyzeDir(dir, rules, []string{"py"}, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Len(t, results[filepath.Join(dir, "sub/c.py")], 1)
+
+	_, _, err = AnalyzeDir(filepath.Join(dir, "missing"), rules, nil, 0)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func Test_HasAllowedExtension(t *testing.T) {
+	assert.True(t, HasAllowedExtension("src/a.ts", nil))
+	assert.True(t, HasAllowedExtension("src/a.ts", []string{".js", ".ts"}))
+	assert.True(t, HasAllowedExtension("src/a.ts", []string{"ts"}))
+	assert.False(t, HasAllowedExtension("src/a.tsx", []string{".ts"}))
+	assert.False(t, HasAllowedExtension("Makefile", []string{".ts"}))
+}
@@ -0,0 +1,256 @@
+package one
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// An EmbeddedRegion is a piece of code written in one language that
+// is embedded in a file written in another, like a code fence in Markdown.
+type EmbeddedRegion struct {
+	// Language is the language the code in the region is written in.
+	Language Language
+	// Source is the code in the region.
+	Source []byte
+	// StartByte and StartPoint are where the region starts in the surrounding file.
+	StartByte  uint32
+	StartPoint sitter.Point
+}
+
+// fenceLanguages maps the info strings of Markdown code fences to languages.
+var fenceLanguages = map[string]Language{
+	"py":         LangPy,
+	"python":     LangPy,
+	"js":         LangJs,
+	"jsx":        LangJs,
+	"javascript": LangJs,
+	"ts":         LangTs,
+	"typescript": LangTs,
+	"tsx":        LangTsx,
+	"cs":         LangCSharp,
+	"csharp":     LangCSharp,
+	"lua":        LangLua,
+	"php":        LangPhp,
+	"kt":         LangKotlin,
+	"kotlin":     LangKotlin,
+	"swift":      LangSwift,
+	"ex":         LangElixir,
+	"elixir":     LangElixir,
+	"scala":      LangScala,
+}
+
+// openingFence returns the fence characters (like "```") and the info string
+// of `line`, if it opens a code fence.
+func openingFence(line string) (fence string, info string, ok bool) {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 || len(trimmed) < 3 {
+		return "", "", false
+	}
+
+	char := trimmed[0]
+	if char != '`' && char != '~' {
+		return "", "", false
+	}
+
+	length := 0
+	for length < len(trimmed) && trimmed[length] == char {
+		length++
+	}
+
+	if length < 3 {
+		return "", "", false
+	}
+
+	fields := strings.Fields(trimmed[length:])
+	if len(fields) > 0 {
+		info = strings.ToLower(fields[0])
+	}
+
+	return trimmed[:length], info, true
+}
+
+func closesFence(line, fence string) bool {
+	trimmed := strings.TrimSpace(line)
+	return strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == ""
+}
+
+// MarkdownRegions returns the code fences in a Markdown document whose
+// language is supported, in the order they appear.
+// A fence that is never closed extends to the end of the document.
+func MarkdownRegions(source []byte) []EmbeddedRegion {
+	var regions []EmbeddedRegion
+
+	var (
+		fence     string
+		lang      Language
+		codeStart int
+		codeRow   uint32
+	)
+
+	inFence := false
+	offset := 0
+	lines := bytes.SplitAfter(source, []byte("\n"))
+	for row, line := range lines {
+		text := strings.TrimRight(string(line), "\r\n")
+		switch {
+		case !inFence:
+			var info string
+			var ok bool
+			if fence, info, ok = openingFence(text); ok {
+				inFence = true
+				lang = fenceLanguages[info]
+				codeStart = offset + len(line)
+				codeRow = uint32(row + 1)
+			}
+
+		case closesFence(text, fence):
+			inFence = false
+			if lang != LangUnknown {
+				regions = append(regions, EmbeddedRegion{
+					Language:   lang,
+					Source:     source[codeStart:offset],
+					StartByte:  uint32(codeStart),
+					StartPoint: sitter.Point{Row: codeRow},
+				})
+			}
+		}
+
+		offset += len(line)
+	}
+
+	if inFence && lang != LangUnknown && codeStart <= len(source) {
+		regions = append(regions, EmbeddedRegion{
+			Language:   lang,
+			Source:     source[codeStart:],
+			StartByte:  uint32(codeStart),
+			StartPoint: sitter.Point{Row: codeRow},
+		})
+	}
+
+	return regions
+}
+
+// SyntaxErrorRuleName is the `RuleName` of the issues returned by `SyntaxErrors`.
+const SyntaxErrorRuleName = "syntax-error"
+
+// syntaxErrorCollector is a Walker that collects the ERROR and MISSING nodes in a tree.
+type syntaxErrorCollector struct {
+	issues []*Issue
+}
+
+func (c *syntaxErrorCollector) OnEnterNode(node *sitter.Node) bool {
+	switch {
+	case node.IsMissing():
+		c.issues = append(c.issues, &Issue{
+			Message:  fmt.Sprintf("Syntax error: missing '%s'", node.Type()),
+			Severity: SeverityError,
+			RuleName: SyntaxErrorRuleName,
+			Range:    node.Range(),
+			Node:     node,
+		})
+		return false
+
+	case node.IsError():
+		c.issues = append(c.issues, &Issue{
+			Message:  "Syntax error",
+			Severity: SeverityError,
+			RuleName: SyntaxErrorRuleName,
+			Range:    node.Range(),
+			Node:     node,
+		})
+		// the children of an ERROR node are not worth reporting on their own.
+		return false
+	}
+
+	return node.HasError()
+}
+
+func (c *syntaxErrorCollector) OnLeaveNode(node *sitter.Node) {}
+
+// SyntaxErrors returns an issue for every syntax error in the file.
+func (pr *ParseResult) SyntaxErrors() []*Issue {
+	if !pr.Ast.HasError() {
+		return nil
+	}
+
+	collector := &syntaxErrorCollector{}
+	WalkAll(pr.Ast, collector)
+	return collector.issues
+}
+
+// offsetBy moves `r`, a range inside `region`, to the same location in the surrounding file.
+func (region *EmbeddedRegion) offsetBy(r sitter.Range) sitter.Range {
+	offsetPoint := func(p sitter.Point) sitter.Point {
+		if p.Row == 0 {
+			p.Column += region.StartPoint.Column
+		}
+
+		p.Row += region.StartPoint.Row
+		return p
+	}
+
+	return sitter.Range{
+		StartPoint: offsetPoint(r.StartPoint),
+		EndPoint:   offsetPoint(r.EndPoint),
+		StartByte:  r.StartByte + region.StartByte,
+		EndByte:    r.EndByte + region.StartByte,
+	}
+}
+
+// moveToFile moves the ranges of `issue` from `region` to the surrounding file.
+// `Node` is cleared, since it belongs to the tree of the region.
+func (region *EmbeddedRegion) moveToFile(issue *Issue) {
+	issue.Range = region.offsetBy(issue.Range)
+	issue.Node = nil
+	for i := range issue.Related {
+		issue.Related[i].Range = region.offsetBy(issue.Related[i].Range)
+	}
+
+	if issue.Fix != nil {
+		fix := *issue.Fix
+		fix.Range = region.offsetBy(fix.Range)
+		issue.Fix = &fix
+	}
+}
+
+// AnalyzeEmbedded analyzes every region with the rules for its language, and
+// returns the issues with their ranges moved to the surrounding file at `filePath`.
+// Every region is parsed on its own, so a syntax error in one region doesn't affect the others.
+// Regions that have syntax errors are not analyzed; their syntax errors are reported instead.
+func AnalyzeEmbedded(filePath string, regions []EmbeddedRegion, rules map[Language][]Rule) []*Issue {
+	var issues []*Issue
+	for i := range regions {
+		region := &regions[i]
+		grammar := region.Language.Grammar()
+		if grammar == nil {
+			continue
+		}
+
+		parsed, err := Parse(filePath, region.Source, region.Language, grammar)
+		if err != nil {
+			issues = append(issues, &Issue{
+				Message:  fmt.Sprintf("Failed to parse %s code: %v", region.Language, err),
+				Severity: SeverityError,
+				RuleName: SyntaxErrorRuleName,
+				Range:    region.offsetBy(sitter.Range{}),
+			})
+			continue
+		}
+
+		regionIssues := parsed.SyntaxErrors()
+		if len(regionIssues) == 0 {
+			regionIssues = NewAnalyzer(parsed, rules[region.Language]).Analyze()
+		}
+
+		for _, issue := range regionIssues {
+			region.moveToFile(issue)
+		}
+
+		issues = append(issues, regionIssues...)
+	}
+
+	return issues
+}
@@ -0,0 +1,83 @@
+package one
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const markdown = "# Usage\n" +
+	"\n" +
+	"```js\n" +
+	"let = (;\n" +
+	"```\n" +
+	"\n" +
+	"```sh\n" +
+	"npm install\n" +
+	"```\n" +
+	"\n" +
+	"~~~javascript title=\"example.js\"\n" +
+	"const x = a\n" +
+	"~~~\n"
+
+func Test_MarkdownRegions(t *testing.T) {
+	regions := MarkdownRegions([]byte(markdown))
+	require.Len(t, regions, 2)
+
+	assert.Equal(t, LangJs, regions[0].Language)
+	assert.Equal(t, "let = (;\n", string(regions[0].Source))
+	assert.Equal(t, sitter.Point{Row: 3}, regions[0].StartPoint)
+
+	assert.Equal(t, LangJs, regions[1].Language)
+	assert.Equal(t, "const x = a\n", string(regions[1].Source))
+	assert.Equal(t, sitter.Point{Row: 11}, regions[1].StartPoint)
+	assert.Equal(t, "const", markdown[regions[1].StartByte:regions[1].StartByte+5])
+
+	// an unclosed fence extends to the end of the document
+	unclosed := MarkdownRegions([]byte("text\n````py\nx = 1\n```\ny = 2"))
+	require.Len(t, unclosed, 1)
+	assert.Equal(t, "x = 1\n```\ny = 2", string(unclosed[0].Source))
+}
+
+func Test_AnalyzeEmbedded(t *testing.T) {
+	source := []byte(markdown)
+	rules := map[Language][]Rule{LangJs: {reportAll("identifier")}}
+	issues := AnalyzeEmbedded("README.md", MarkdownRegions(source), rules)
+	require.NotEmpty(t, issues)
+
+	var syntaxErrors, ruleIssues []*Issue
+	for _, issue := range issues {
+		assert.Nil(t, issue.Node)
+		if issue.RuleName == SyntaxErrorRuleName {
+			syntaxErrors = append(syntaxErrors, issue)
+		} else {
+			ruleIssues = append(ruleIssues, issue)
+		}
+	}
+
+	// the broken fence is reported, but not analyzed
+	require.NotEmpty(t, syntaxErrors)
+	for _, issue := range syntaxErrors {
+		assert.Equal(t, uint32(3), issue.Range.StartPoint.Row)
+		assert.Equal(t, SeverityError, issue.Severity)
+	}
+
+	// the valid fence after it is still analyzed, with ranges in the Markdown file
+	require.Len(t, ruleIssues, 2)
+	assert.Equal(t, "x", ruleIssues[0].Message)
+	assert.Equal(t, sitter.Point{Row: 11, Column: 6}, ruleIssues[0].Range.StartPoint)
+	assert.Equal(t, "a", ruleIssues[1].Message)
+	start, end := ruleIssues[1].Range.StartByte, ruleIssues[1].Range.EndByte
+	assert.Equal(t, "a", markdown[start:end])
+}
+
+func Test_SyntaxErrors(t *testing.T) {
+	assert.Empty(t, parseFile(t, "let x = 1").SyntaxErrors())
+
+	issues := parseFile(t, "if (x) { y()").SyntaxErrors()
+	require.Len(t, issues, 1)
+	assert.Equal(t, "Syntax error: missing '}'", issues[0].Message)
+	assert.Equal(t, SyntaxErrorRuleName, issues[0].RuleName)
+}
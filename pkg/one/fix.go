@@ -0,0 +1,225 @@
+package one
+
+import (
+	"fmt"
+	"sort"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// A Fix is an edit to the source code that resolves an issue.
+type Fix struct {
+	// Range is the portion of the source that should be replaced.
+	// Only the byte offsets are used when applying a fix.
+	Range sitter.Range
+	// Replacement is the text that `Range` is replaced with
+	Replacement string
+}
+
+// FixStrategy decides which fix is applied when two fixes overlap.
+type FixStrategy int
+
+const (
+	// FixFirstWins applies the fix that starts first in the source.
+	FixFirstWins FixStrategy = iota
+	// FixLastWins applies the fix that starts last in the source.
+	FixLastWins
+	// FixHighestSeverityWins applies the fix whose issue has the highest severity.
+	// Ties are broken like FixFirstWins.
+	FixHighestSeverityWins
+	// FixAbort applies no fixes at all if any two of them overlap.
+	FixAbort
+)
+
+func (s FixStrategy) String() string {
+	switch s {
+	case FixFirstWins:
+		return "first-wins"
+	case FixLastWins:
+		return "last-wins"
+	case FixHighestSeverityWins:
+		return "highest-severity-wins"
+	case FixAbort:
+		return "abort"
+	default:
+		return "unknown"
+	}
+}
+
+// DroppedFix is a fix that was not applied.
+type DroppedFix struct {
+	Issue *Issue
+	// Reason explains why the fix was dropped.
+	Reason string
+	// ConflictsWith is the issue whose fix overlaps with this one, if any.
+	ConflictsWith *Issue
+}
+
+// FixReport lists the fixes that were applied, and the ones that weren't.
+type FixReport struct {
+	Applied []*Issue
+	Dropped []DroppedFix
+}
+
+func fixesOverlap(a, b *Fix) bool {
+	return a.Range.StartByte < b.Range.EndByte && b.Range.StartByte < a.Range.EndByte
+}
+
+// byPriority sorts the fixable issues so that the fixes that should win a conflict come first.
+func byPriority(issues []*Issue, strategy FixStrategy) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i].Fix.Range, issues[j].Fix.Range
+		switch strategy {
+		case FixLastWins:
+			return a.StartByte > b.StartByte
+		case FixHighestSeverityWins:
+			if issues[i].Severity != issues[j].Severity {
+				return issues[i].Severity > issues[j].Severity
+			}
+		}
+
+		return a.StartByte < b.StartByte
+	})
+}
+
+func ruleOf(issue *Issue) string {
+	if issue.RuleName == "" {
+		return "another rule"
+	}
+
+	return fmt.Sprintf("'%s'", issue.RuleName)
+}
+
+// ApplyFixes applies the fixes attached to `issues` to `source`,
+// and returns the modified source.
+// When two fixes overlap, only the one that starts first is applied.
+// `source` itself is not modified.
+func ApplyFixes(source []byte, issues []*Issue) []byte {
+	fixed, _, _ := ApplyFixesWithStrategy(source, issues, FixFirstWins)
+	return fixed
+}
+
+// ApplyFixesWithStrategy is like ApplyFixes, but uses `strategy` to resolve
+// overlapping fixes, and reports which fixes were applied and which were dropped.
+// With FixAbort, an error is returned if any two fixes overlap, and no fixes are applied.
+func ApplyFixesWithStrategy(source []byte, issues []*Issue, strategy FixStrategy) ([]byte, *FixReport, error) {
+	report := &FixReport{}
+
+	var candidates []*Issue
+	for _, issue := range issues {
+		if issue.Fix == nil {
+			continue
+		}
+
+		if issue.Fix.Range.StartByte > issue.Fix.Range.EndByte || issue.Fix.Range.EndByte > uint32(len(source)) {
+			report.Dropped = append(report.Dropped, DroppedFix{Issue: issue, Reason: "range is outside the source"})
+			continue
+		}
+
+		candidates = append(candidates, issue)
+	}
+
+	byPriority(candidates, strategy)
+
+	var accepted []*Issue
+	conflicts := 0
+	for _, issue := range candidates {
+		var conflict *Issue
+		for _, other := range accepted {
+			if fixesOverlap(issue.Fix, other.Fix) {
+				conflict = other
+				break
+			}
+		}
+
+		if conflict == nil {
+			accepted = append(accepted, issue)
+			continue
+		}
+
+		conflicts++
+		report.Dropped = append(report.Dropped, DroppedFix{
+			Issue:         issue,
+			Reason:        fmt.Sprintf("overlaps with a fix from %s (%s)", ruleOf(conflict), strategy),
+			ConflictsWith: conflict,
+		})
+	}
+
+	if strategy == FixAbort && conflicts > 0 {
+		return nil, report, fmt.Errorf("cannot apply fixes: %d fixes overlap with others", conflicts)
+	}
+
+	// insertions at the same offset are applied in the order they were reported.
+	isAccepted := map[*Issue]bool{}
+	for _, issue := range accepted {
+		isAccepted[issue] = true
+	}
+
+	accepted = accepted[:0]
+	for _, issue := range issues {
+		if isAccepted[issue] {
+			accepted = append(accepted, issue)
+		}
+	}
+
+	sort.SliceStable(accepted, func(i, j int) bool {
+		a, b := accepted[i].Fix.Range, accepted[j].Fix.Range
+		if a.StartByte != b.StartByte {
+			return a.StartByte < b.StartByte
+		}
+
+		return a.EndByte < b.EndByte
+	})
+
+	fixed := make([]byte, 0, len(source))
+	offset := uint32(0)
+	for _, issue := range accepted {
+		fix := issue.Fix
+		fixed = append(fixed, source[offset:fix.Range.StartByte]...)
+		fixed = append(fixed, fix.Replacement...)
+		offset = fix.Range.EndByte
+	}
+
+	report.Applied = accepted
+	return append(fixed, source[offset:]...), report, nil
+}
+
+const defaultMaxFixPasses = 10
+
+// FixUntilStable reads the file at `filePath`, and repeatedly analyzes it with `rules`
+// and applies the fixes that were found, until no fix changes the source anymore.
+// A fix can reveal issues that are fixable in turn (e.g: removing an import
+// leaves behind blank lines that another rule removes).
+// At most `maxPasses` rounds of fixes are applied (10 when <= 0), and the loop stops early
+// if the source goes back to an earlier version, which happens when two rules undo each other.
+// It returns the fixed source, and the issues that remain in it.
+// The file itself is not modified.
+func FixUntilStable(filePath string, rules []Rule, maxPasses int) ([]byte, []*Issue, error) {
+	if maxPasses <= 0 {
+		maxPasses = defaultMaxFixPasses
+	}
+
+	parsed, err := ParseFile(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seen := map[string]bool{string(parsed.Source): true}
+	for pass := 0; ; pass++ {
+		issues := NewAnalyzer(parsed, rules).Analyze()
+		if pass >= maxPasses {
+			return parsed.Source, issues, nil
+		}
+
+		fixed := ApplyFixes(parsed.Source, issues)
+		if seen[string(fixed)] {
+			return parsed.Source, issues, nil
+		}
+
+		seen[string(fixed)] = true
+		parsed, err = Parse(filePath, fixed, parsed.Language, parsed.TsLanguage)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+}
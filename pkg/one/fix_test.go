@@ -0,0 +1,168 @@
+package one
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func replace(start, end uint32, replacement string) *Issue {
+	return &Issue{
+		Fix: &Fix{
+			Range:       sitter.Range{StartByte: start, EndByte: end},
+			Replacement: replacement,
+		},
+	}
+}
+
+func Test_ApplyFixes(t *testing.T) {
+	source := []byte("let a = b == c")
+
+	fixed := ApplyFixes(source, []*Issue{
+		replace(10, 12, "==="),
+		{Message: "no fix"},
+		replace(0, 3, "const"),
+	})
+	assert.Equal(t, "const a = b === c", string(fixed))
+	assert.Equal(t, "let a = b == c", string(source))
+
+	// overlapping fixes are skipped
+	fixed = ApplyFixes(source, []*Issue{
+		replace(8, 14, "x"),
+		replace(10, 12, "==="),
+	})
+	assert.Equal(t, "let a = x", string(fixed))
+}
+
+func Test_ApplyFixesWithStrategy(t *testing.T) {
+	source := []byte("let a = b == c")
+
+	wide := replace(8, 14, "x")
+	wide.RuleName = "wide"
+	narrow := replace(10, 12, "===")
+	narrow.RuleName = "narrow"
+	narrow.Severity = SeverityError
+	issues := []*Issue{wide, narrow, replace(0, 3, "const")}
+
+	fixed, report, err := ApplyFixesWithStrategy(source, issues, FixFirstWins)
+	assert.NoError(t, err)
+	assert.Equal(t, "const a = x", string(fixed))
+	assert.Equal(t, 2, len(report.Applied))
+	assert.Equal(t, []DroppedFix{{
+		Issue:         narrow,
+		Reason:        "overlaps with a fix from 'wide' (first-wins)",
+		ConflictsWith: wide,
+	}}, report.Dropped)
+
+	fixed, report, err = ApplyFixesWithStrategy(source, issues, FixLastWins)
+	assert.NoError(t, err)
+	assert.Equal(t, "const a = b === c", string(fixed))
+	assert.Equal(t, wide, report.Dropped[0].Issue)
+
+	narrow.Severity = SeverityInfo
+	fixed, _, err = ApplyFixesWithStrategy(source, issues, FixHighestSeverityWins)
+	assert.NoError(t, err)
+	assert.Equal(t, "const a = x", string(fixed))
+
+	narrow.Severity = SeverityError
+	fixed, _, err = ApplyFixesWithStrategy(source, issues, FixHighestSeverityWins)
+	assert.NoError(t, err)
+	assert.Equal(t, "const a = b === c", string(fixed))
+
+	fixed, report, err = ApplyFixesWithStrategy(source, issues, FixAbort)
+	assert.Error(t, err)
+	assert.Nil(t, fixed)
+	assert.Equal(t, 1, len(report.Dropped))
+
+	// insertions at the same offset don't conflict, and keep their order.
+	fixed, report, err = ApplyFixesWithStrategy(source, []*Issue{
+		replace(3, 3, "!"),
+		replace(3, 3, "?"),
+		replace(3, 5, " b"),
+		replace(40, 41, "out of range"),
+	}, FixAbort)
+	assert.NoError(t, err)
+	assert.Equal(t, "let!? b = b == c", string(fixed))
+	assert.Equal(t, "range is outside the source", report.Dropped[0].Reason)
+}
+
+// dropFirstChar fixes identifiers with more than one character
+// by removing their first character, one pass at a time.
+func dropFirstChar() Rule {
+	var entry VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) {
+		name := node.Content(ana.ParseResult.Source)
+		if len(name) < 2 {
+			return
+		}
+
+		ana.Report(&Issue{
+			Message: "long name",
+			Range:   node.Range(),
+			Fix:     &Fix{Range: node.Range(), Replacement: name[1:]},
+		})
+	}
+
+	return CreateRule("drop-first-char", "identifier", LangJs, &entry, nil)
+}
+
+// swapAB rewrites `a` to `b` and `b` to `a`, so it never settles.
+func swapAB() Rule {
+	var entry VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) {
+		replacement := "a"
+		if node.Content(ana.ParseResult.Source) == "a" {
+			replacement = "b"
+		}
+
+		ana.Report(&Issue{Fix: &Fix{Range: node.Range(), Replacement: replacement}})
+	}
+
+	return CreateRule("swap", "identifier", LangJs, &entry, nil)
+}
+
+// growName appends an `x` to every identifier, so the source never repeats.
+func growName() Rule {
+	var entry VisitFn = func(r Rule, ana *Analyzer, node *sitter.Node) {
+		replacement := node.Content(ana.ParseResult.Source) + "x"
+		ana.Report(&Issue{Fix: &Fix{Range: node.Range(), Replacement: replacement}})
+	}
+
+	return CreateRule("grow", "identifier", LangJs, &entry, nil)
+}
+
+func Test_FixUntilStable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.js")
+	require.NoError(t, os.WriteFile(path, []byte("abcd(x)"), 0o644))
+
+	fixed, issues, err := FixUntilStable(path, []Rule{dropFirstChar()}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "d(x)", string(fixed))
+	assert.Empty(t, issues)
+
+	fixed, issues, err = FixUntilStable(path, []Rule{dropFirstChar()}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "cd(x)", string(fixed))
+	assert.Equal(t, 1, len(issues))
+
+	// the file on disk is left untouched
+	source, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "abcd(x)", string(source))
+
+	require.NoError(t, os.WriteFile(path, []byte("a"), 0o644))
+	fixed, _, err = FixUntilStable(path, []Rule{swapAB()}, 100)
+	require.NoError(t, err)
+	assert.Equal(t, "b", string(fixed))
+
+	// a negative limit means the default, not "unlimited"
+	fixed, _, err = FixUntilStable(path, []Rule{growName()}, -1)
+	require.NoError(t, err)
+	assert.Equal(t, "a"+strings.Repeat("x", defaultMaxFixPasses), string(fixed))
+
+	_, _, err = FixUntilStable(filepath.Join(t.TempDir(), "missing.js"), nil, 0)
+	assert.Error(t, err)
+}
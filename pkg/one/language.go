@@ -2,12 +2,24 @@ package one
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
 
 	sitter "github.com/smacker/go-tree-sitter"
+	treeSitterCsharp "github.com/smacker/go-tree-sitter/csharp"
+	treeSitterElixir "github.com/smacker/go-tree-sitter/elixir"
+	treeSitterKotlin "github.com/smacker/go-tree-sitter/kotlin"
+	treeSitterLua "github.com/smacker/go-tree-sitter/lua"
+	treeSitterPhp "github.com/smacker/go-tree-sitter/php"
 	treeSitterPy "github.com/smacker/go-tree-sitter/python"
+	treeSitterScala "github.com/smacker/go-tree-sitter/scala"
+	treeSitterSwift "github.com/smacker/go-tree-sitter/swift"
 	treeSitterTsx "github.com/smacker/go-tree-sitter/typescript/tsx"
 	treeSitterTs "github.com/smacker/go-tree-sitter/typescript/typescript"
 )
@@ -38,23 +50,26 @@ const (
 	LangJs  // vanilla JS and JSX
 	LangTs  // TypeScript (not TSX)
 	LangTsx // TypeScript with JSX extension
+	LangCSharp
+	LangLua
+	LangPhp
+	LangKotlin
+	LangSwift
+	LangElixir
+	LangScala
 )
 
-// tsGrammarForLang returns the tree-sitter grammar for the given language.
-// May return `nil` when `lang` is `LangUnkown`.
-func (lang Language) Grammar() *sitter.Language {
-	switch lang {
-	case LangPy:
-		return treeSitterPy.GetLanguage()
-	case LangJs:
-		return treeSitterTsx.GetLanguage()
-	case LangTs:
-		return treeSitterTs.GetLanguage()
-	case LangTsx:
-		return treeSitterTsx.GetLanguage()
-	default:
-		return nil
-	}
+// languageInfo describes how files written in a language are recognized and parsed.
+type languageInfo struct {
+	// name is the human readable name of the language
+	name string
+	// extensions are the file extensions (with a leading dot) that map to the language
+	extensions []string
+	// aliases are the (lowercase) names that `DecodeLanguage` accepts for the language,
+	// like the `language` field of a pattern rule.
+	aliases []string
+	// grammar returns the tree-sitter grammar used to parse the language
+	grammar func() *sitter.Language
 }
 
 // NOTE(@injuly): TypeScript and TSX have to parsed with DIFFERENT
@@ -62,29 +77,139 @@ func (lang Language) Grammar() *sitter.Language {
 // parsed as a (legacy) type-cast in TS, but a JSXElement in TSX.
 // See: https://facebook.github.io/jsx/#prod-JSXElement
 
+// NOTE: Dart and XML aren't supported. The go-tree-sitter version that this module
+// depends on doesn't ship a grammar for either of them. The HTML grammar can't stand in
+// for XML, since it parses the `<?xml ?>` prolog as an ERROR node.
+// Both can still be added with `RegisterLanguage` and an out-of-tree grammar.
+
+// languages is the registry of all supported languages.
+// Adding a language only requires an entry here. Scope resolution is opt-in,
+// and needs a `ScopeBuilder` for the language in `MakeScopeTree`.
+var languages = map[Language]languageInfo{
+	LangPy: {name: "Python", extensions: []string{".py"}, aliases: []string{"python", "py"}, grammar: treeSitterPy.GetLanguage},
+	// NOTE: .jsx and .js can both have JSX syntax, so both are parsed as TSX by default.
+	// See `TreatJsAsJsx`.
+	LangJs:     {name: "JavaScript", extensions: []string{".js", ".jsx"}, aliases: []string{"javascript", "js"}, grammar: treeSitterTsx.GetLanguage},
+	LangTs:     {name: "TypeScript", extensions: []string{".ts"}, aliases: []string{"typescript", "ts"}, grammar: treeSitterTs.GetLanguage},
+	LangTsx:    {name: "TSX", extensions: []string{".tsx"}, aliases: []string{"jsx", "tsx"}, grammar: treeSitterTsx.GetLanguage},
+	LangCSharp: {name: "C#", extensions: []string{".cs"}, aliases: []string{"csharp", "c#", "cs"}, grammar: treeSitterCsharp.GetLanguage},
+	LangLua:    {name: "Lua", extensions: []string{".lua"}, aliases: []string{"lua"}, grammar: treeSitterLua.GetLanguage},
+	LangPhp:    {name: "PHP", extensions: []string{".php"}, aliases: []string{"php"}, grammar: treeSitterPhp.GetLanguage},
+	LangKotlin: {name: "Kotlin", extensions: []string{".kt", ".kts"}, aliases: []string{"kotlin", "kt"}, grammar: treeSitterKotlin.GetLanguage},
+	LangSwift:  {name: "Swift", extensions: []string{".swift"}, aliases: []string{"swift"}, grammar: treeSitterSwift.GetLanguage},
+	LangElixir: {name: "Elixir", extensions: []string{".ex", ".exs"}, aliases: []string{"elixir", "ex"}, grammar: treeSitterElixir.GetLanguage},
+	LangScala:  {name: "Scala", extensions: []string{".scala", ".sc"}, aliases: []string{"scala"}, grammar: treeSitterScala.GetLanguage},
+}
+
+// TreatJsAsJsx makes `.js` files parse with the TSX grammar, so that they can contain JSX.
+// This is the default, since a lot of React code puts JSX in `.js` files.
+//
+// When false, `LangJs` uses the TypeScript grammar instead, and `.js` files can't have JSX,
+// but expressions like `<T>value` parse as type assertions rather than broken JSX elements.
+// `.jsx` files are still parsed as TSX. Pattern rules read with `ReadFromFile` are
+// compiled for the grammar of each file, so they match in both.
+//
+// Must be set before any files are parsed.
+var TreatJsAsJsx = true
+
+// RegisterLanguage adds a language to the registry, so that files with one of the
+// extensions `exts` (with a leading dot, like ".zig") are parsed with `grammar`.
+// This lets grammars that aren't built into OneLint be used without modifying it.
+// The name of the language is its first extension, without the dot,
+// which is also the name that `DecodeLanguage` accepts for it.
+//
+// `lang` must be a value that isn't used by any of the built-in languages, like `Language(1000)`.
+// RegisterLanguage panics if `lang` or any of `exts` is already registered, so it
+// should be called once per language, at init time before any file is parsed.
+func RegisterLanguage(lang Language, exts []string, grammar func() *sitter.Language) {
+	if lang == LangUnknown || grammar == nil || len(exts) == 0 {
+		panic("one: RegisterLanguage needs a language, a grammar, and at least one extension")
+	}
+
+	if info, exists := languages[lang]; exists {
+		panic(fmt.Sprintf("one: language %d is already registered as %s", lang, info.name))
+	}
+
+	for _, ext := range exts {
+		if other := LanguageFromFilePath("file" + ext); other != LangUnknown {
+			panic(fmt.Sprintf("one: extension %q is already registered for %s", ext, other))
+		}
+	}
+
+	name := strings.TrimPrefix(exts[0], ".")
+	languages[lang] = languageInfo{
+		name:       name,
+		extensions: slices.Clone(exts),
+		aliases:    []string{strings.ToLower(name)},
+		grammar:    grammar,
+	}
+}
+
+// Grammar returns the tree-sitter grammar for the given language.
+// May return `nil` when `lang` is `LangUnkown`.
+func (lang Language) Grammar() *sitter.Language {
+	if lang == LangJs && !TreatJsAsJsx {
+		return treeSitterTs.GetLanguage()
+	}
+
+	if info, ok := languages[lang]; ok {
+		return info.grammar()
+	}
+
+	return nil
+}
+
+// String returns the human readable name of the language, like "TypeScript".
+func (lang Language) String() string {
+	if info, ok := languages[lang]; ok {
+		return info.name
+	}
+
+	return "unknown"
+}
+
+// Extensions returns the file extensions (with a leading dot) that map to `lang`.
+func (lang Language) Extensions() []string {
+	return slices.Clone(languages[lang].extensions)
+}
+
+// SupportedLanguages returns all languages that files can be parsed as,
+// in the order they are declared.
+func SupportedLanguages() []Language {
+	var langs []Language
+	for lang := range languages {
+		langs = append(langs, lang)
+	}
+
+	slices.Sort(langs)
+	return langs
+}
+
 // LanguageFromFilePath returns the Language of the file at the given path
 // returns `LangUnkown` if the language is not recognized (e.g: `.txt` files).
 func LanguageFromFilePath(path string) Language {
 	ext := filepath.Ext(path)
-	switch ext {
-	case ".py":
-		return LangPy
-		// TODO: .jsx and .js can both have JSX syntax -_-
-	case ".js", ".jsx":
-		return LangJs
-	case ".ts":
-		return LangTs
-	case ".tsx":
-		return LangTsx
-	default:
-		return LangUnknown
+	for lang, info := range languages {
+		if slices.Contains(info.extensions, ext) {
+			return lang
+		}
 	}
+
+	return LangUnknown
 }
 
+var (
+	// ErrUnsupportedLanguage is returned when a file is written in a language
+	// that has no grammar. Callers usually skip these files.
+	ErrUnsupportedLanguage = errors.New("unsupported language")
+	// ErrParseFailed is returned when tree-sitter fails to parse a file.
+	ErrParseFailed = errors.New("failed to parse")
+)
+
 func Parse(filePath string, source []byte, language Language, grammar *sitter.Language) (*ParseResult, error) {
 	ast, err := sitter.ParseCtx(context.Background(), source, grammar)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse %s", filePath)
+		return nil, fmt.Errorf("%w %s: %w", ErrParseFailed, filePath, err)
 	}
 
 	scopeTree := MakeScopeTree(language, ast, source)
@@ -102,11 +227,13 @@ func Parse(filePath string, source []byte, language Language, grammar *sitter.La
 
 // ParseFile parses the file at the given path using the appropriate
 // tree-sitter grammar.
+// Returns an error that wraps `ErrUnsupportedLanguage` if the extension
+// of the file isn't recognized.
 func ParseFile(filePath string) (*ParseResult, error) {
 	lang := LanguageFromFilePath(filePath)
-	grammar := lang.Grammar()
+	grammar := grammarOfFile(filePath, lang)
 	if grammar == nil {
-		return nil, fmt.Errorf("unsupported file type: %s", filePath)
+		return nil, fmt.Errorf("%w: %s (extension %q)", ErrUnsupportedLanguage, filePath, filepath.Ext(filePath))
 	}
 
 	source, err := os.ReadFile(filePath)
@@ -116,3 +243,69 @@ func ParseFile(filePath string) (*ParseResult, error) {
 
 	return Parse(filePath, source, lang, grammar)
 }
+
+// grammarOfFile returns the grammar used to parse the file at `path`, written in `lang`.
+// Usually `lang.Grammar()`, except for `.jsx` files, which always need JSX support.
+func grammarOfFile(path string, lang Language) *sitter.Language {
+	if lang == LangJs && filepath.Ext(path) == ".jsx" {
+		return treeSitterTsx.GetLanguage()
+	}
+
+	return lang.Grammar()
+}
+
+// ParseFiles parses the files at `paths` in parallel, with at most `concurrency`
+// files being parsed at once (the number of CPUs when `concurrency` <= 0).
+// It returns the files that were parsed, and the errors for those that
+// couldn't be, both keyed by path.
+func ParseFiles(paths []string, concurrency int) (map[string]*ParseResult, map[string]error) {
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
+
+	results := make(map[string]*ParseResult, len(paths))
+	errs := map[string]error{}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	// every parse holds a slot in `slots` while it runs.
+	slots := make(chan struct{}, concurrency)
+	for _, path := range paths {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			parsed, err := ParseFile(path)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs[path] = err
+			} else {
+				results[path] = parsed
+			}
+		}()
+	}
+
+	wg.Wait()
+	return results, errs
+}
+
+// StringFilePath is the placeholder `FilePath` for sources
+// parsed with `ParseString`.
+const StringFilePath = "<string>"
+
+// ParseString parses `source` as a file written in `lang`.
+// Useful for tests and quick experiments, where there is no file on disk.
+func ParseString(source string, lang Language) (*ParseResult, error) {
+	grammar := lang.Grammar()
+	if grammar == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedLanguage, lang)
+	}
+
+	return Parse(StringFilePath, []byte(source), lang, grammar)
+}
@@ -0,0 +1,300 @@
+package one
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	treeSitterLua "github.com/smacker/go-tree-sitter/lua"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseAs(t *testing.T, lang Language, source string) *ParseResult {
+	parsed, err := ParseString(source, lang)
+	require.NoError(t, err)
+	require.NotNil(t, parsed)
+	return parsed
+}
+
+func Test_ParseString(t *testing.T) {
+	parsed, err := ParseString("x = 1", LangPy)
+	require.NoError(t, err)
+	assert.Equal(t, StringFilePath, parsed.FilePath)
+	assert.Equal(t, LangPy, parsed.Language)
+	assert.Equal(t, "module", parsed.Ast.Type())
+
+	_, err = ParseString("x = 1", LangUnknown)
+	assert.ErrorIs(t, err, ErrUnsupportedLanguage)
+}
+
+func Test_UnsupportedLanguageError(t *testing.T) {
+	_, err := ParseFile("docs/notes.txt")
+	require.ErrorIs(t, err, ErrUnsupportedLanguage)
+	assert.NotErrorIs(t, err, ErrParseFailed)
+	assert.Contains(t, err.Error(), "docs/notes.txt")
+	assert.Contains(t, err.Error(), `".txt"`)
+
+	// there is no XML grammar to parse these with.
+	assert.Equal(t, LangUnknown, LanguageFromFilePath("pom.xml"))
+	assert.Equal(t, LangUnknown, LanguageFromFilePath("icon.svg"))
+}
+
+func Test_ParseFiles(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for i := 0; i < 10; i++ {
+		path := filepath.Join(dir, fmt.Sprintf("file%d.js", i))
+		require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("const x = %d", i)), 0o644))
+		paths = append(paths, path)
+	}
+
+	notes := filepath.Join(dir, "notes.txt")
+	require.NoError(t, os.WriteFile(notes, []byte("hello"), 0o644))
+	missing := filepath.Join(dir, "missing.py")
+	paths = append(paths, notes, missing)
+
+	for _, concurrency := range []int{0, 1, 3} {
+		results, errs := ParseFiles(paths, concurrency)
+		require.Len(t, results, 10)
+		require.Len(t, errs, 2)
+
+		for i := 0; i < 10; i++ {
+			parsed := results[paths[i]]
+			require.NotNil(t, parsed)
+			assert.Equal(t, paths[i], parsed.FilePath)
+			assert.Equal(t, fmt.Sprintf("const x = %d", i), string(parsed.Source))
+		}
+
+		assert.ErrorIs(t, errs[notes], ErrUnsupportedLanguage)
+		assert.ErrorIs(t, errs[missing], os.ErrNotExist)
+	}
+}
+
+func Test_CSharp(t *testing.T) {
+	assert.Equal(t, LangCSharp, LanguageFromFilePath("Program.cs"))
+
+	parsed := parseAs(t, LangCSharp, `
+		class Greeter {
+			void Greet() { System.Console.WriteLine("hi"); }
+		}`)
+	assert.Equal(t, "compilation_unit", parsed.Ast.Type())
+	assert.Equal(t, 1, len(parsed.FindAll("class_declaration")))
+	assert.Equal(t, 1, len(parsed.FindAll("method_declaration")))
+	assert.Nil(t, parsed.ScopeTree)
+}
+
+func Test_Lua(t *testing.T) {
+	assert.Equal(t, LangLua, LanguageFromFilePath("init.lua"))
+
+	parsed := parseAs(t, LangLua, `
+		local function greet(name)
+			print("hello " .. name)
+		end
+		greet("world")`)
+	// NOTE: the Lua grammar bundled with go-tree-sitter calls
+	// function declarations `function_statement`.
+	assert.Equal(t, "program", parsed.Ast.Type())
+	assert.Equal(t, 1, len(parsed.FindAll("function_statement")))
+	assert.Equal(t, 2, len(parsed.FindAll("function_call")))
+	assert.Nil(t, parsed.ScopeTree)
+}
+
+func Test_Php(t *testing.T) {
+	assert.Equal(t, LangPhp, LanguageFromFilePath("index.php"))
+
+	parsed := parseAs(t, LangPhp, `<?php echo "hi";`)
+	assert.Equal(t, "program", parsed.Ast.Type())
+	assert.Equal(t, 1, len(parsed.FindAll("echo_statement")))
+
+	parsed = parseAs(t, LangPhp, `<?php
+		function greet($name) { return strtoupper($name); }
+		class Greeter {
+			public function greet() { return greet("world"); }
+		}`)
+	assert.Equal(t, 1, len(parsed.FindAll("function_definition")))
+	assert.Equal(t, 1, len(parsed.FindAll("method_declaration")))
+	assert.Equal(t, 2, len(parsed.FindAll("function_call_expression")))
+	assert.Nil(t, parsed.ScopeTree)
+}
+
+func Test_Kotlin(t *testing.T) {
+	assert.Equal(t, LangKotlin, LanguageFromFilePath("Main.kt"))
+	assert.Equal(t, LangKotlin, LanguageFromFilePath("build.gradle.kts"))
+
+	parsed := parseAs(t, LangKotlin, `fun main() {}`)
+	assert.Equal(t, "source_file", parsed.Ast.Type())
+	assert.Equal(t, 1, len(parsed.FindAll("function_declaration")))
+
+	parsed = parseAs(t, LangKotlin, `
+		class Greeter(val name: String) {
+			fun greet() = println("hi $name")
+		}`)
+	assert.Equal(t, 1, len(parsed.FindAll("class_declaration")))
+	assert.Equal(t, 1, len(parsed.FindAll("function_declaration")))
+	assert.Nil(t, parsed.ScopeTree)
+}
+
+func Test_Swift(t *testing.T) {
+	assert.Equal(t, LangSwift, LanguageFromFilePath("AppDelegate.swift"))
+
+	parsed := parseAs(t, LangSwift, `
+		class Greeter {
+			func greet(name: String) { print("hi \(name)") }
+		}
+		func main() {}`)
+	assert.Equal(t, "source_file", parsed.Ast.Type())
+	assert.Equal(t, 1, len(parsed.FindAll("class_declaration")))
+	assert.Equal(t, 2, len(parsed.FindAll("function_declaration")))
+	assert.Nil(t, MakeScopeTree(LangSwift, parsed.Ast, parsed.Source))
+}
+
+func Test_Elixir(t *testing.T) {
+	assert.Equal(t, LangElixir, LanguageFromFilePath("lib/app/router.ex"))
+	assert.Equal(t, LangElixir, LanguageFromFilePath("test/test_helper.exs"))
+
+	parsed := parseAs(t, LangElixir, `
+		defmodule Greeter do
+			def greet(name), do: "hi #{name}"
+			defp secret, do: 1
+		end`)
+	assert.Equal(t, "source", parsed.Ast.Type())
+	// `defmodule`, `def` and `defp` are all modelled as calls
+	assert.Equal(t, 4, len(parsed.FindAll("call")))
+	assert.Nil(t, MakeScopeTree(LangElixir, parsed.Ast, parsed.Source))
+}
+
+func Test_Scala(t *testing.T) {
+	assert.Equal(t, LangScala, LanguageFromFilePath("src/main/scala/Main.scala"))
+	assert.Equal(t, LangScala, LanguageFromFilePath("build.sc"))
+
+	parsed := parseAs(t, LangScala, `
+		object Main {
+			def main(args: Array[String]): Unit = println("hi")
+		}
+		class Greeter(name: String) { def greet(): String = s"hi $name" }`)
+	assert.Equal(t, "compilation_unit", parsed.Ast.Type())
+	assert.Equal(t, 1, len(parsed.FindAll("object_definition")))
+	assert.Equal(t, 1, len(parsed.FindAll("class_definition")))
+	assert.Equal(t, 2, len(parsed.FindAll("function_definition")))
+	assert.Nil(t, MakeScopeTree(LangScala, parsed.Ast, parsed.Source))
+}
+
+func Test_TreatJsAsJsx(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, source string) string {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.WriteFile(path, []byte(source), 0o644))
+		return path
+	}
+
+	cast := write("cast.js", "const n = <any>value")
+	jsx := write("app.js", "const el = <div>hi</div>")
+	component := write("component.jsx", "const el = <div>hi</div>")
+
+	parse := func(path string) *ParseResult {
+		parsed, err := ParseFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, LangJs, parsed.Language)
+		return parsed
+	}
+
+	// by default, `<any>` is the start of a JSX element.
+	assert.True(t, parse(cast).Ast.HasError())
+	assert.Empty(t, parse(cast).FindAll("type_assertion"))
+	assert.Len(t, parse(jsx).FindAll("jsx_element"), 1)
+
+	TreatJsAsJsx = false
+	t.Cleanup(func() { TreatJsAsJsx = true })
+
+	parsed := parse(cast)
+	assert.False(t, parsed.Ast.HasError())
+	assert.Len(t, parsed.FindAll("type_assertion"), 1)
+	assert.True(t, parse(jsx).Ast.HasError())
+	assert.Len(t, parse(component).FindAll("jsx_element"), 1)
+	assert.NotNil(t, parsed.ScopeTree)
+
+	// pattern rules for JavaScript match in `.jsx` files too, though those use another grammar now.
+	ruleFile := write("declarations.yml", "language: js\ncode: declarations\nmessage: declaration\npattern: (lexical_declaration) @decl\n")
+	rule, err := ReadFromFile(ruleFile)
+	require.NoError(t, err)
+	for _, path := range []string{cast, write("cast.jsx", "const n = value")} {
+		analyzer := NewAnalyzer(parse(path), nil)
+		analyzer.PatternRules = []PatternRule{rule}
+		assert.Len(t, analyzer.Analyze(), 1, path)
+	}
+}
+
+func Test_SupportedLanguages(t *testing.T) {
+	langs := SupportedLanguages()
+	assert.Equal(t, LangPy, langs[0])
+	assert.NotContains(t, langs, LangUnknown)
+
+	for _, lang := range langs {
+		assert.NotNil(t, lang.Grammar(), lang.String())
+		assert.NotEqual(t, "unknown", lang.String())
+
+		for _, ext := range lang.Extensions() {
+			assert.Equal(t, lang, LanguageFromFilePath("file"+ext), ext)
+		}
+	}
+
+	assert.Equal(t, "TypeScript", LangTs.String())
+	assert.Equal(t, []string{".js", ".jsx"}, LangJs.Extensions())
+	assert.Equal(t, "unknown", LangUnknown.String())
+	assert.Empty(t, LangUnknown.Extensions())
+}
+
+func Test_LanguageRegistry(t *testing.T) {
+	// every extension must map to exactly one language
+	seen := map[string]Language{}
+	for lang, info := range languages {
+		assert.NotNil(t, info.grammar, info.name)
+		for _, ext := range info.extensions {
+			other, exists := seen[ext]
+			assert.False(t, exists, "%s is claimed by both %s and %s", ext, lang, other)
+			seen[ext] = lang
+		}
+	}
+
+	// and so must every alias
+	seenAliases := map[string]Language{}
+	for lang, info := range languages {
+		assert.NotEmpty(t, info.aliases, info.name)
+		for _, alias := range info.aliases {
+			other, exists := seenAliases[alias]
+			assert.False(t, exists, "%s is claimed by both %s and %s", alias, lang, other)
+			seenAliases[alias] = lang
+		}
+	}
+
+	assert.Equal(t, LangJs, DecodeLanguage("JavaScript"))
+	assert.Equal(t, LangTsx, DecodeLanguage("jsx"))
+	assert.Equal(t, LangCSharp, DecodeLanguage("c#"))
+	assert.Equal(t, LangUnknown, DecodeLanguage("dart"))
+}
+
+func Test_RegisterLanguage(t *testing.T) {
+	const langLuau = Language(1000)
+	t.Cleanup(func() { delete(languages, langLuau) })
+
+	RegisterLanguage(langLuau, []string{".luau"}, treeSitterLua.GetLanguage)
+	assert.Equal(t, langLuau, LanguageFromFilePath("src/init.luau"))
+	assert.Equal(t, "luau", langLuau.String())
+	assert.Equal(t, []string{".luau"}, langLuau.Extensions())
+	assert.Contains(t, SupportedLanguages(), langLuau)
+	assert.Equal(t, langLuau, DecodeLanguage("Luau"))
+	require.NotNil(t, langLuau.Grammar())
+
+	path := filepath.Join(t.TempDir(), "init.luau")
+	require.NoError(t, os.WriteFile(path, []byte("local x = 1"), 0o644))
+	parsed, err := ParseFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, langLuau, parsed.Language)
+	assert.Equal(t, "program", parsed.Ast.Type())
+
+	assert.Panics(t, func() { RegisterLanguage(langLuau, []string{".other"}, treeSitterLua.GetLanguage) })
+	assert.Panics(t, func() { RegisterLanguage(Language(1001), []string{".lua"}, treeSitterLua.GetLanguage) })
+	assert.Panics(t, func() { RegisterLanguage(Language(1001), nil, treeSitterLua.GetLanguage) })
+}
@@ -0,0 +1,58 @@
+package one
+
+import (
+	"path/filepath"
+	"sync"
+
+	"github.com/gobwas/glob"
+)
+
+// PathPatterns is a list of glob patterns that rule options can use
+// to change the behavior of a rule for certain files.
+// e.g: allowing `console.log` in test files.
+//
+// Patterns are matched against slash-separated file paths.
+// `*` does not match across path separators, `**` does.
+type PathPatterns []string
+
+// compiledGlobs caches compiled patterns, since rules typically
+// match the same handful of patterns for every node they visit.
+var compiledGlobs sync.Map // map[string]glob.Glob
+
+func compileGlob(pattern string) (glob.Glob, error) {
+	if g, ok := compiledGlobs.Load(pattern); ok {
+		return g.(glob.Glob), nil
+	}
+
+	g, err := glob.Compile(pattern, '/')
+	if err != nil {
+		return nil, err
+	}
+
+	compiledGlobs.Store(pattern, g)
+	return g, nil
+}
+
+// Match reports whether `path` matches any of the patterns.
+// Malformed patterns never match.
+func (patterns PathPatterns) Match(path string) bool {
+	path = filepath.ToSlash(path)
+	for _, pattern := range patterns {
+		g, err := compileGlob(pattern)
+		if err != nil {
+			continue
+		}
+
+		if g.Match(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FileMatches reports whether the path of the file being analyzed
+// matches any of the given patterns.
+func (ana *Analyzer) FileMatches(patterns PathPatterns) bool {
+	return patterns.Match(ana.ParseResult.FilePath)
+}
@@ -3,7 +3,9 @@ package one
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+	"sync"
 
 	sitter "github.com/smacker/go-tree-sitter"
 	"gopkg.in/yaml.v3"
@@ -19,11 +21,24 @@ type PatternRule interface {
 	OnMatch(ana *Analyzer, matchedNode *sitter.Node)
 }
 
+// grammarPattern is implemented by pattern rules that can compile their
+// pattern for any grammar, rather than only the one returned by `Pattern`.
+// This matters for languages that are parsed with more than one grammar, like
+// `LangJs` (see `TreatJsAsJsx`), since a query only matches trees of the grammar it was compiled for.
+type grammarPattern interface {
+	PatternFor(grammar *sitter.Language) *sitter.Query
+}
+
 type patternRuleImpl struct {
 	language     Language
 	pattern      *sitter.Query
 	issueMessage string
 	issueId      *string
+	// source is the text of the pattern. Empty if the rule was created from a compiled query.
+	source string
+	// queries caches `source` compiled for every grammar it was needed for.
+	queries   map[sitter.Language]*sitter.Query
+	queriesMu sync.Mutex
 }
 
 func (r *patternRuleImpl) Language() Language {
@@ -34,12 +49,40 @@ func (r *patternRuleImpl) Pattern() *sitter.Query {
 	return r.pattern
 }
 
+// PatternFor returns the pattern compiled for `grammar`,
+// or nil if it isn't valid in that grammar.
+func (r *patternRuleImpl) PatternFor(grammar *sitter.Language) *sitter.Query {
+	if r.source == "" {
+		return r.pattern
+	}
+
+	r.queriesMu.Lock()
+	defer r.queriesMu.Unlock()
+	if query, ok := r.queries[*grammar]; ok {
+		return query
+	}
+
+	query, err := sitter.NewQuery([]byte(r.source), grammar)
+	if err != nil {
+		query = nil
+	}
+
+	r.queries[*grammar] = query
+	return query
+}
+
 func (r *patternRuleImpl) OnMatch(ana *Analyzer, matchedNode *sitter.Node) {
-	ana.Report(&Issue{
+	issue := &Issue{
 		Range:   matchedNode.Range(),
 		Message: r.issueMessage,
 		Id:      r.issueId,
-	})
+	}
+
+	if r.issueId != nil {
+		issue.RuleName = *r.issueId
+	}
+
+	ana.Report(issue)
 }
 
 func CreatePatternRule(pattern *sitter.Query, language Language, issueMessage string, issueId *string) PatternRule {
@@ -59,20 +102,17 @@ type PatternRuleFile struct {
 	Description string `yaml:"description"`
 }
 
+// DecodeLanguage returns the language with the (case-insensitive) name `language`,
+// like "javascript" or "py". Returns `LangUnknown` if no language has that name.
 func DecodeLanguage(language string) Language {
 	language = strings.ToLower(language)
-	switch language {
-	case "javascript", "js":
-		return LangJs
-	case "typescript", "ts":
-		return LangTs
-	case "jsx", "tsx":
-		return LangTsx
-	case "python", "py":
-		return LangPy
-	default:
-		return LangUnknown
+	for lang, info := range languages {
+		if slices.Contains(info.aliases, language) {
+			return lang
+		}
 	}
+
+	return LangUnknown
 }
 
 // ReadFromFile reads a pattern rule definition from a YAML config file.
@@ -92,11 +132,20 @@ func ReadFromFile(filePath string) (PatternRule, error) {
 		return nil, fmt.Errorf("unknown language code: '%s'", rule.Language)
 	}
 
-	pattern, err := sitter.NewQuery([]byte(rule.Pattern), lang.Grammar())
+	grammar := lang.Grammar()
+	pattern, err := sitter.NewQuery([]byte(rule.Pattern), grammar)
 	if err != nil {
 		return nil, err
 	}
 
-	patternRule := CreatePatternRule(pattern, lang, rule.Message, &rule.Code)
+	patternRule := &patternRuleImpl{
+		language:     lang,
+		pattern:      pattern,
+		issueMessage: rule.Message,
+		issueId:      &rule.Code,
+		source:       rule.Pattern,
+		queries:      map[sitter.Language]*sitter.Query{*grammar: pattern},
+	}
+
 	return patternRule, nil
 }
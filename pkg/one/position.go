@@ -0,0 +1,74 @@
+package one
+
+import (
+	"fmt"
+	"unicode/utf16"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// PositionFormat decides how lines and columns are numbered when the position
+// of an issue is shown to a user, or handed to another tool.
+// tree-sitter numbers both from 0, but most editors and compilers number them from 1.
+// The zero value numbers lines and columns from 1.
+//
+// `FormatByRule` and the `one lint` command use 1-based positions by default.
+// `Issue.Range`, `Suppression.Line` and `ruletest` always use 0-based positions,
+// just like tree-sitter does.
+type PositionFormat struct {
+	// ZeroBasedLines numbers the first line of a file 0 instead of 1.
+	ZeroBasedLines bool
+	// ZeroBasedColumns numbers the first column of a line 0 instead of 1.
+	ZeroBasedColumns bool
+}
+
+// LineAndColumn converts a (0-based) tree-sitter point to a line and column in format `f`.
+func (f PositionFormat) LineAndColumn(point sitter.Point) (line, column int) {
+	line, column = int(point.Row), int(point.Column)
+	if !f.ZeroBasedLines {
+		line++
+	}
+
+	if !f.ZeroBasedColumns {
+		column++
+	}
+
+	return line, column
+}
+
+// Format returns `point` as "line:column" in format `f`.
+func (f PositionFormat) Format(point sitter.Point) string {
+	line, column := f.LineAndColumn(point)
+	return fmt.Sprintf("%d:%d", line, column)
+}
+
+// lineOffsets returns the byte offset at which each line of `source` starts.
+func lineOffsets(source []byte) []uint32 {
+	offsets := []uint32{0}
+	for i, b := range source {
+		if b == '\n' {
+			offsets = append(offsets, uint32(i+1))
+		}
+	}
+
+	return offsets
+}
+
+// utf16Point converts the byte column of `point` to a column in UTF-16 code units,
+// which is what source maps (and JavaScript strings) count in.
+// `lines` are the line offsets of `source`, as returned by `lineOffsets`.
+func utf16Point(source []byte, lines []uint32, point sitter.Point) sitter.Point {
+	if int(point.Row) >= len(lines) {
+		return point
+	}
+
+	start := lines[point.Row]
+	end := min(start+point.Column, uint32(len(source)))
+
+	column := uint32(0)
+	for _, r := range string(source[start:end]) {
+		column += uint32(utf16.RuneLen(r))
+	}
+
+	return sitter.Point{Row: point.Row, Column: column}
+}
@@ -0,0 +1,40 @@
+package one
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PositionFormat(t *testing.T) {
+	// `x` is at the first column of the second line
+	parsed := parseFile(t, "a\nx")
+	ids := parsed.FindAll("identifier")
+	require.Len(t, ids, 2)
+	point := ids[1].StartPoint()
+
+	line, column := PositionFormat{}.LineAndColumn(point)
+	assert.Equal(t, 2, line)
+	assert.Equal(t, 1, column)
+
+	line, column = PositionFormat{ZeroBasedLines: true, ZeroBasedColumns: true}.LineAndColumn(point)
+	assert.Equal(t, 1, line)
+	assert.Equal(t, 0, column)
+
+	assert.Equal(t, "2:1", PositionFormat{}.Format(point))
+	assert.Equal(t, "1:1", PositionFormat{ZeroBasedLines: true}.Format(point))
+	assert.Equal(t, "2:0", PositionFormat{ZeroBasedColumns: true}.Format(point))
+	assert.Equal(t, "1:1", PositionFormat{}.Format(sitter.Point{}))
+}
+
+func Test_FormatByRuleWith(t *testing.T) {
+	results := map[string][]*Issue{
+		"a.js": {issueAt("js-no-eval", 3, 0, "no eval")},
+	}
+
+	assert.Equal(t, "js-no-eval (1)\n  a.js:4:1 no eval\n", FormatByRuleWith(results, PositionFormat{}))
+	zeroBased := PositionFormat{ZeroBasedLines: true, ZeroBasedColumns: true}
+	assert.Equal(t, "js-no-eval (1)\n  a.js:3:0 no eval\n", FormatByRuleWith(results, zeroBased))
+}
@@ -0,0 +1,246 @@
+package one
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// unnamedRule is the group for issues that weren't raised by a named rule.
+const unnamedRule = "<unnamed>"
+
+type issueInFile struct {
+	file  string
+	issue *Issue
+}
+
+// groupByRule groups the issues in `results` (file path -> issues) by the rule that raised them.
+// Within a group, issues are sorted by file and position.
+func groupByRule(results map[string][]*Issue) map[string][]issueInFile {
+	groups := map[string][]issueInFile{}
+	for file, issues := range results {
+		for _, issue := range issues {
+			name := issue.RuleName
+			if name == "" {
+				name = unnamedRule
+			}
+
+			groups[name] = append(groups[name], issueInFile{file: file, issue: issue})
+		}
+	}
+
+	for _, group := range groups {
+		sort.Slice(group, func(i, j int) bool {
+			a, b := group[i], group[j]
+			if a.file != b.file {
+				return a.file < b.file
+			}
+
+			return a.issue.Range.StartByte < b.issue.Range.StartByte
+		})
+	}
+
+	return groups
+}
+
+// rulesByCount returns the rule names in `counts`, with the most frequent ones first.
+func rulesByCount(counts map[string]int) []string {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		a, b := names[i], names[j]
+		if counts[a] != counts[b] {
+			return counts[a] > counts[b]
+		}
+
+		return a < b
+	})
+
+	return names
+}
+
+// FormatByRule renders `results` (file path -> issues) grouped by rule name
+// rather than by file, with the rules that raised the most issues first.
+// Every issue is listed as `file:line:col` (1-based) followed by its message.
+func FormatByRule(results map[string][]*Issue) string {
+	return FormatByRuleWith(results, PositionFormat{})
+}
+
+// FormatByRuleWith is like FormatByRule, but numbers lines and columns using `positions`.
+func FormatByRuleWith(results map[string][]*Issue, positions PositionFormat) string {
+	groups := groupByRule(results)
+	counts := map[string]int{}
+	for name, group := range groups {
+		counts[name] = len(group)
+	}
+
+	var sb strings.Builder
+	for i, name := range rulesByCount(counts) {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+
+		group := groups[name]
+		fmt.Fprintf(&sb, "%s (%d)\n", name, len(group))
+		for _, item := range group {
+			start := positions.Format(item.issue.Range.StartPoint)
+			fmt.Fprintf(&sb, "  %s:%s %s\n", item.file, start, item.issue.Message)
+		}
+	}
+
+	return sb.String()
+}
+
+// Summary is an aggregate view of the issues found in a run.
+type Summary struct {
+	TotalIssues int
+	// FilesWithIssues is the number of files in which at least one issue was found.
+	FilesWithIssues int
+	BySeverity      map[Severity]int
+	// ByRule maps a rule name to the number of issues it raised.
+	// Issues without a rule name are counted under "<unnamed>".
+	ByRule map[string]int
+}
+
+// Summarize aggregates `results` (file path -> issues) into a Summary.
+func Summarize(results map[string][]*Issue) Summary {
+	summary := Summary{
+		BySeverity: map[Severity]int{},
+		ByRule:     map[string]int{},
+	}
+
+	for name, group := range groupByRule(results) {
+		summary.ByRule[name] = len(group)
+	}
+
+	for _, issues := range results {
+		if len(issues) > 0 {
+			summary.FilesWithIssues++
+		}
+
+		for _, issue := range issues {
+			summary.TotalIssues++
+			summary.BySeverity[issue.Severity]++
+		}
+	}
+
+	return summary
+}
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, noun)
+	}
+
+	return fmt.Sprintf("%d %ss", count, noun)
+}
+
+// String renders the summary as a short report, e.g:
+//
+//	Found 3 issues in 2 files (1 error, 2 warnings)
+//	  js-no-console: 2
+//	  js-no-eval: 1
+func (s Summary) String() string {
+	if s.TotalIssues == 0 {
+		return "No issues found"
+	}
+
+	var counts []string
+	for _, severity := range []Severity{SeverityError, SeverityWarning, SeverityInfo} {
+		count := s.BySeverity[severity]
+		switch {
+		case count == 0:
+			continue
+		case severity == SeverityInfo:
+			counts = append(counts, fmt.Sprintf("%d info", count))
+		default:
+			counts = append(counts, plural(count, severity.String()))
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Found %s in %s (%s)",
+		plural(s.TotalIssues, "issue"),
+		plural(s.FilesWithIssues, "file"),
+		strings.Join(counts, ", "),
+	)
+
+	for _, name := range rulesByCount(s.ByRule) {
+		fmt.Fprintf(&sb, "\n  %s: %d", name, s.ByRule[name])
+	}
+
+	return sb.String()
+}
+
+// MergeResults concatenates the issues raised by several analyzers on the same file
+// (e.g: core rules, plugin rules, and custom rules), in the order they are passed.
+// Issues are not copied, so each one keeps the rule that raised it.
+// Use `DedupeIssues` and `SortIssues` to clean up the merged list.
+func MergeResults(lists ...[]*Issue) []*Issue {
+	var merged []*Issue
+	for _, issues := range lists {
+		merged = append(merged, issues...)
+	}
+
+	return merged
+}
+
+type issueKey struct {
+	rule, message      string
+	startByte, endByte uint32
+}
+
+// DedupeIssues removes issues that were raised by the same rule, with the same message,
+// at the same range as an earlier issue in the list. e.g: when a rule is
+// registered with two analyzers that ran on the same file.
+func DedupeIssues(issues []*Issue) []*Issue {
+	seen := map[issueKey]bool{}
+	var unique []*Issue
+	for _, issue := range issues {
+		key := issueKey{issue.RuleName, issue.Message, issue.Range.StartByte, issue.Range.EndByte}
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		unique = append(unique, issue)
+	}
+
+	return unique
+}
+
+// SortIssues sorts issues by their position in the file, and then by the name of the rule
+// that raised them. Issues that are otherwise equal keep their relative order.
+func SortIssues(issues []*Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.Range.StartByte != b.Range.StartByte {
+			return a.Range.StartByte < b.Range.StartByte
+		}
+
+		if a.Range.EndByte != b.Range.EndByte {
+			return a.Range.EndByte < b.Range.EndByte
+		}
+
+		return a.RuleName < b.RuleName
+	})
+}
+
+// CollapseRanges returns copies of `issues` whose ranges end where they start,
+// for consumers that only need a point location (e.g: editors that don't
+// handle multi-line diagnostics well).
+// The original issues are left untouched, and fixes keep their full range.
+func CollapseRanges(issues []*Issue) []*Issue {
+	collapsed := make([]*Issue, 0, len(issues))
+	for _, issue := range issues {
+		copied := *issue
+		copied.Range.EndPoint = copied.Range.StartPoint
+		copied.Range.EndByte = copied.Range.StartByte
+		collapsed = append(collapsed, &copied)
+	}
+
+	return collapsed
+}
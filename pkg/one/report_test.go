@@ -0,0 +1,114 @@
+package one
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func issueAt(rule string, row, col uint32, message string) *Issue {
+	return &Issue{
+		RuleName: rule,
+		Message:  message,
+		Range: sitter.Range{
+			StartPoint: sitter.Point{Row: row, Column: col},
+			StartByte:  row*100 + col,
+		},
+	}
+}
+
+func Test_FormatByRule(t *testing.T) {
+	results := map[string][]*Issue{
+		"src/b.js": {
+			issueAt("js-no-console", 4, 2, "no console"),
+			issueAt("js-no-eval", 0, 0, "no eval"),
+		},
+		"src/a.js": {
+			issueAt("js-no-console", 9, 0, "no console"),
+			issueAt("js-no-console", 1, 4, "no console"),
+		},
+		"lib/c.js": {
+			issueAt("", 2, 0, "custom"),
+		},
+	}
+
+	expected := `js-no-console (3)
+  src/a.js:2:5 no console
+  src/a.js:10:1 no console
+  src/b.js:5:3 no console
+
+<unnamed> (1)
+  lib/c.js:3:1 custom
+
+js-no-eval (1)
+  src/b.js:1:1 no eval
+`
+	assert.Equal(t, expected, FormatByRule(results))
+	assert.Equal(t, "", FormatByRule(nil))
+}
+
+func Test_Summarize(t *testing.T) {
+	eval := issueAt("js-no-eval", 0, 0, "no eval")
+	eval.Severity = SeverityError
+	info := issueAt("js-no-console", 3, 0, "no console")
+	info.Severity = SeverityInfo
+
+	results := map[string][]*Issue{
+		"a.js": {issueAt("js-no-console", 1, 0, "no console"), eval},
+		"b.js": {info, issueAt("js-no-console", 9, 0, "no console")},
+		"c.js": {},
+	}
+
+	summary := Summarize(results)
+	assert.Equal(t, 4, summary.TotalIssues)
+	assert.Equal(t, 2, summary.FilesWithIssues)
+	assert.Equal(t, map[Severity]int{SeverityError: 1, SeverityWarning: 2, SeverityInfo: 1}, summary.BySeverity)
+	assert.Equal(t, map[string]int{"js-no-console": 3, "js-no-eval": 1}, summary.ByRule)
+
+	expected := `Found 4 issues in 2 files (1 error, 2 warnings, 1 info)
+  js-no-console: 3
+  js-no-eval: 1`
+	assert.Equal(t, expected, summary.String())
+	assert.Equal(t, "No issues found", Summarize(nil).String())
+}
+
+func Test_MergeResults(t *testing.T) {
+	core := []*Issue{issueAt("js-no-eval", 2, 0, "no eval"), issueAt("js-no-console", 0, 4, "no console")}
+	plugin := []*Issue{issueAt("plugin-rule", 1, 0, "plugin"), issueAt("js-no-eval", 2, 0, "no eval")}
+
+	merged := MergeResults(core, nil, plugin)
+	require.Equal(t, 4, len(merged))
+	assert.Same(t, core[0], merged[0])
+	assert.Same(t, plugin[1], merged[3])
+
+	unique := DedupeIssues(merged)
+	require.Equal(t, 3, len(unique))
+	assert.Same(t, core[0], unique[0])
+
+	SortIssues(unique)
+	var rules []string
+	for _, issue := range unique {
+		rules = append(rules, issue.RuleName)
+	}
+
+	assert.Equal(t, []string{"js-no-console", "plugin-rule", "js-no-eval"}, rules)
+	assert.Empty(t, MergeResults())
+}
+
+func Test_CollapseRanges(t *testing.T) {
+	issue := issueAt("js-no-eval", 1, 2, "no eval")
+	issue.Range.EndPoint = sitter.Point{Row: 4, Column: 1}
+	issue.Range.EndByte = 401
+
+	collapsed := CollapseRanges([]*Issue{issue})
+	require.Equal(t, 1, len(collapsed))
+	assert.Equal(t, sitter.Point{Row: 1, Column: 2}, collapsed[0].Range.EndPoint)
+	assert.Equal(t, uint32(102), collapsed[0].Range.EndByte)
+	assert.Equal(t, issue.Message, collapsed[0].Message)
+
+	// the original range is kept
+	assert.Equal(t, sitter.Point{Row: 4, Column: 1}, issue.Range.EndPoint)
+	assert.Equal(t, uint32(401), issue.Range.EndByte)
+}
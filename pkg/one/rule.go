@@ -7,29 +7,174 @@ import (
 type VisitFn func(rule Rule, analyzer *Analyzer, node *sitter.Node)
 
 type Rule interface {
+	// Name is a unique name for the rule, e.g: "js-no-double-eq"
+	Name() string
 	NodeType() string
 	GetLanguage() Language
 	OnEnter() *VisitFn
 	OnLeave() *VisitFn
 }
 
+// MultiNodeRule is a Rule that is invoked for more than one type of node.
+// e.g: a rule that inspects every kind of function (declarations, expressions, arrows).
+type MultiNodeRule interface {
+	Rule
+	// NodeTypes returns all the node types that this rule should be invoked for.
+	NodeTypes() []string
+}
+
+// DependentRule is a Rule that needs other rules to run before it,
+// e.g: because it consumes facts that those rules compute.
+type DependentRule interface {
+	Rule
+	// Dependencies returns the names of the rules that should
+	// be invoked before this one on every node.
+	Dependencies() []string
+}
+
+// DocumentedRule is a Rule that has a page of documentation explaining
+// what it checks for, and how to fix the issues it raises.
+type DocumentedRule interface {
+	Rule
+	// DocURL returns a link to the documentation of the rule.
+	DocURL() string
+}
+
 type ruleImpl struct {
-	nodeType string
-	language Language
-	onEnter  *VisitFn
-	onLeave  *VisitFn
+	name      string
+	nodeTypes []string
+	language  Language
+	onEnter   *VisitFn
+	onLeave   *VisitFn
 }
 
-func (r *ruleImpl) NodeType() string      { return r.nodeType }
+func (r *ruleImpl) Name() string          { return r.name }
+func (r *ruleImpl) NodeType() string      { return r.nodeTypes[0] }
+func (r *ruleImpl) NodeTypes() []string   { return r.nodeTypes }
 func (r *ruleImpl) GetLanguage() Language { return r.language }
 func (r *ruleImpl) OnEnter() *VisitFn     { return r.onEnter }
 func (r *ruleImpl) OnLeave() *VisitFn     { return r.onLeave }
 
-func CreateRule(nodeType string, language Language, onEnter, onLeave *VisitFn) Rule {
+func CreateRule(name string, nodeType string, language Language, onEnter, onLeave *VisitFn) Rule {
 	return &ruleImpl{
-		nodeType: nodeType,
-		language: language,
-		onEnter:  onEnter,
-		onLeave:  onLeave,
-	} 
+		name:      name,
+		nodeTypes: []string{nodeType},
+		language:  language,
+		onEnter:   onEnter,
+		onLeave:   onLeave,
+	}
+}
+
+// CreateMultiNodeRule creates a rule that is invoked for every node
+// whose type is one of `nodeTypes`.
+func CreateMultiNodeRule(name string, nodeTypes []string, language Language, onEnter, onLeave *VisitFn) Rule {
+	return &ruleImpl{
+		name:      name,
+		nodeTypes: nodeTypes,
+		language:  language,
+		onEnter:   onEnter,
+		onLeave:   onLeave,
+	}
+}
+
+// CreateTextRule creates a rule that inspects the source text of a file
+// as a whole, rather than individual nodes.
+// `check` is invoked once per file, with the root node of the AST.
+func CreateTextRule(name string, language Language, check VisitFn) Rule {
+	return CreateRule(name, FileNodeType, language, &check, nil)
+}
+
+type dependentRule struct {
+	Rule
+	dependencies []string
+}
+
+func (r *dependentRule) Dependencies() []string { return r.dependencies }
+func (r *dependentRule) NodeTypes() []string    { return nodeTypesOfRule(r.Rule) }
+func (r *dependentRule) DocURL() string         { return DocURLOf(r.Rule) }
+
+// WithDependencies returns a rule that behaves just like `rule`, but is always
+// invoked after the rules named in `dependencies` by analyzers
+// that have all of them registered.
+func WithDependencies(rule Rule, dependencies ...string) Rule {
+	return &dependentRule{Rule: rule, dependencies: dependencies}
+}
+
+type documentedRule struct {
+	Rule
+	docURL string
+}
+
+func (r *documentedRule) DocURL() string      { return r.docURL }
+func (r *documentedRule) NodeTypes() []string { return nodeTypesOfRule(r.Rule) }
+func (r *documentedRule) Dependencies() []string {
+	if dependent, ok := r.Rule.(DependentRule); ok {
+		return dependent.Dependencies()
+	}
+
+	return nil
+}
+
+// WithDocURL returns a rule that behaves just like `rule`, but links to
+// the documentation at `url`. The link is attached to every issue that the rule
+// raises, under the `MetadataDocsURL` key.
+func WithDocURL(rule Rule, url string) Rule {
+	return &documentedRule{Rule: rule, docURL: url}
+}
+
+// DocURLOf returns the link to the documentation of `rule`,
+// or an empty string if the rule isn't documented.
+func DocURLOf(rule Rule) string {
+	if documented, ok := rule.(DocumentedRule); ok {
+		return documented.DocURL()
+	}
+
+	return ""
+}
+
+// nodeTypesOfRule returns all the node types that a rule should be invoked for.
+func nodeTypesOfRule(rule Rule) []string {
+	if multi, ok := rule.(MultiNodeRule); ok {
+		return multi.NodeTypes()
+	}
+
+	return []string{rule.NodeType()}
+}
+
+// sortByDependencies orders `rules` such that every rule comes after its dependencies.
+// Rules that don't depend on each other stay in their original order.
+// Dependencies that aren't in `rules` are ignored, and so are cyclic dependencies.
+func sortByDependencies(rules []Rule) []Rule {
+	// rules are referred to by their index, since `Rule` implementations don't have to be comparable.
+	rulesWithName := map[string][]int{}
+	for i, rule := range rules {
+		rulesWithName[rule.Name()] = append(rulesWithName[rule.Name()], i)
+	}
+
+	sorted := make([]Rule, 0, len(rules))
+	visited := make([]bool, len(rules))
+
+	var visit func(i int)
+	visit = func(i int) {
+		if visited[i] {
+			return
+		}
+
+		visited[i] = true
+		if dependent, ok := rules[i].(DependentRule); ok {
+			for _, dep := range dependent.Dependencies() {
+				for _, depIndex := range rulesWithName[dep] {
+					visit(depIndex)
+				}
+			}
+		}
+
+		sorted = append(sorted, rules[i])
+	}
+
+	for i := range rules {
+		visit(i)
+	}
+
+	return sorted
 }
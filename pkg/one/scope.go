@@ -14,8 +14,8 @@ import (
 // "unused-import"
 type Reference struct {
 	// IsWriteRef determines if this reference is a write reference.
-	// For write refs, only the expression being assigned is stored.
-	// i.e: for `a = 3`, this list will store the `3` node, not the assignment node
+	// For write refs, `Node` is the identifier being written to, and its
+	// parent is the assignment (`a = 3`, `a += 3`) or update (`a++`) expression.
 	IsWriteRef bool
 	// Variable stores the variable being referenced
 	Variable *Variable
@@ -39,7 +39,8 @@ type Variable struct {
 	Name string
 	// DeclNode is the AST node that declares this variable
 	DeclNode *sitter.Node
-	// Refs is a list of references to this variable throughout the file
+	// Refs is a list of references to this variable throughout the file,
+	// in the order they appear in the source.
 	Refs []*Reference
 }
 
@@ -116,7 +117,11 @@ func BuildScopeTree(builder ScopeBuilder, ast *sitter.Node, source []byte) *Scop
 	root.AstNode = ast
 
 	scopeOfNode := make(map[*sitter.Node]*Scope)
-	buildScopeTree(builder, source, ast, root, scopeOfNode)
+	WalkTree(ast, &scopeTreeBuilder{
+		builder:     builder,
+		scopes:      []*Scope{root},
+		scopeOfNode: scopeOfNode,
+	})
 
 	return &ScopeTree{
 		Language:    builder.GetLanguage(),
@@ -125,51 +130,56 @@ func BuildScopeTree(builder ScopeBuilder, ast *sitter.Node, source []byte) *Scop
 	}
 }
 
-func buildScopeTree(
-	builder ScopeBuilder,
-	source []byte,
-	node *sitter.Node,
-	scope *Scope,
-	scopeOfNode map[*sitter.Node]*Scope,
-) *Scope {
-	builder.OnNodeEnter(node, scope)
-	defer builder.OnNodeExit(node, scope)
-
-	if builder.DeclaresVariable(node) {
-		decls := builder.CollectVariables(node)
+// scopeTreeBuilder is a Walker that builds a scope tree with a `ScopeBuilder`.
+// It walks iteratively (see `WalkTree`), so deeply nested code can't overflow the stack.
+type scopeTreeBuilder struct {
+	builder ScopeBuilder
+	// scopes is the stack of scopes surrounding the node being visited.
+	scopes      []*Scope
+	scopeOfNode map[*sitter.Node]*Scope
+}
+
+func (b *scopeTreeBuilder) currentScope() *Scope {
+	return b.scopes[len(b.scopes)-1]
+}
+
+func (b *scopeTreeBuilder) OnEnterNode(node *sitter.Node) bool {
+	scope := b.currentScope()
+	b.builder.OnNodeEnter(node, scope)
+
+	if b.builder.DeclaresVariable(node) {
+		decls := b.builder.CollectVariables(node)
 		for _, decl := range decls {
 			scope.Variables[decl.Name] = decl
 		}
 	}
 
-	nextScope := scope
-	if builder.NodeCreatesScope(node) {
-		nextScope = NewScope(scope)
-		scopeOfNode[node] = nextScope
-
-		if scope != nil {
-			scope.Children = append(scope.Children, nextScope)
-		} else {
-			scope = nextScope // root
-		}
+	if b.builder.NodeCreatesScope(node) {
+		nextScope := NewScope(scope)
+		nextScope.AstNode = node
+		b.scopeOfNode[node] = nextScope
+		scope.Children = append(scope.Children, nextScope)
+		b.scopes = append(b.scopes, nextScope)
 	}
 
-	for i := 0; i < int(node.NamedChildCount()); i++ {
-		child := node.NamedChild(i)
-		buildScopeTree(builder, source, child, nextScope, scopeOfNode)
+	return true
+}
+
+func (b *scopeTreeBuilder) OnLeaveNode(node *sitter.Node) {
+	if _, createsScope := b.scopeOfNode[node]; createsScope {
+		b.scopes = b.scopes[:len(b.scopes)-1]
 	}
 
-	return scope
+	// like `OnNodeEnter`, this receives the scope that surrounds `node`.
+	b.builder.OnNodeExit(node, b.currentScope())
 }
 
 // GetScope finds the nearest surrounding scope of an AST node
 func (st *ScopeTree) GetScope(node *sitter.Node) *Scope {
-	if scope, exists := st.ScopeOfNode[node]; exists {
-		return scope
-	}
-
-	if parent := node.Parent(); parent != nil {
-		return st.GetScope(parent)
+	for ; node != nil; node = node.Parent() {
+		if scope, exists := st.ScopeOfNode[node]; exists {
+			return scope
+		}
 	}
 
 	return nil
@@ -185,6 +195,11 @@ func MakeScopeTree(lang Language, ast *sitter.Node, source []byte) *ScopeTree {
 			source: source,
 		}
 		return BuildScopeTree(builder, ast, source)
+	case LangLua:
+		// TODO: implement a `ScopeBuilder` for Lua.
+		// Variables are global unless declared with `local`, so the
+		// builder must declare assignments to unbound names in the root scope.
+		return nil
 	default:
 		return nil
 	}
@@ -27,6 +27,10 @@ var ScopeNodes = []string{
 	"statement_block",
 	"function_declaration",
 	"function_expression",
+	"generator_function_declaration",
+	"generator_function",
+	"arrow_function",
+	"method_definition",
 	"for_statement",
 	"for_in_statement",
 	"for_of_statement",
@@ -38,12 +42,49 @@ func (ts *TsScopeBuilder) NodeCreatesScope(node *sitter.Node) bool {
 
 func (ts *TsScopeBuilder) DeclaresVariable(node *sitter.Node) bool {
 	typ := node.Type()
-	return typ == "variable_declarator" || typ == "import_clause" || typ == "import_specifier"
+	switch typ {
+	case "variable_declarator", "import_clause", "import_specifier", "formal_parameters",
+		"function_declaration", "generator_function_declaration":
+		return true
+	}
+
+	// x => ...
+	return isArrowFunctionParam(node) || isForInBinding(node)
+}
+
+func isArrowFunctionParam(node *sitter.Node) bool {
+	// finding the parent of a node takes time proportional to its depth,
+	// so avoid it for nodes that can't be parameters.
+	if node.Type() != "identifier" {
+		return false
+	}
+
+	parent := node.Parent()
+	return parent != nil &&
+		parent.Type() == "arrow_function" &&
+		parent.ChildByFieldName("parameter") == node
+}
+
+// isForInBinding returns true if `node` is the binding declared by a for-in or for-of loop,
+// like `x` in `for (const x of xs)`. Without `const`, `let` or `var`, the left side of the loop
+// is assigned to instead (see `isWriteRef`).
+func isForInBinding(node *sitter.Node) bool {
+	switch node.Type() {
+	case "identifier", "object_pattern", "array_pattern":
+	default:
+		return false
+	}
+
+	parent := node.Parent()
+	return parent != nil &&
+		parent.Type() == "for_in_statement" &&
+		parent.ChildByFieldName("left") == node &&
+		parent.ChildByFieldName("kind") != nil
 }
 
 func (ts *TsScopeBuilder) scanDecl(idOrPattern, declarator *sitter.Node, decls []*Variable) []*Variable {
 	switch idOrPattern.Type() {
-	case "identifier":
+	case "identifier", "shorthand_property_identifier_pattern":
 		// <name> = ...
 		nameStr := idOrPattern.Content(ts.source)
 		decls = append(decls, &Variable{
@@ -68,6 +109,14 @@ func (ts *TsScopeBuilder) scanDecl(idOrPattern, declarator *sitter.Node, decls [
 			decls = ts.scanDecl(pair, declarator, decls)
 		}
 
+		for _, withDefault := range ChildrenOfType(idOrPattern, "object_assignment_pattern") {
+			decls = ts.scanDecl(withDefault, declarator, decls)
+		}
+
+		for _, restPattern := range ChildrenOfType(idOrPattern, "rest_pattern") {
+			decls = ts.scanDecl(restPattern, declarator, decls)
+		}
+
 		// { realName : <alias> } = ...
 		// alias can be an identifier or nested object pattern.
 	case "pair_pattern":
@@ -95,8 +144,24 @@ func (ts *TsScopeBuilder) scanDecl(idOrPattern, declarator *sitter.Node, decls [
 			decls = ts.scanDecl(arrayPattern, declarator, decls)
 		}
 
-		for _, objectPattern := range childrenObjPatterns {
-			decls = ts.scanDecl(objectPattern, declarator, decls)
+		for _, withDefault := range ChildrenOfType(idOrPattern, "assignment_pattern") {
+			decls = ts.scanDecl(withDefault, declarator, decls)
+		}
+
+		for _, restPattern := range ChildrenOfType(idOrPattern, "rest_pattern") {
+			decls = ts.scanDecl(restPattern, declarator, decls)
+		}
+
+	case "object_assignment_pattern", "assignment_pattern":
+		// { <name> = 1 } = ... or [ <name> = 1 ] = ...
+		if binding := idOrPattern.ChildByFieldName("left"); binding != nil {
+			decls = ts.scanDecl(binding, declarator, decls)
+		}
+
+	case "rest_pattern":
+		// ...<rest>
+		if rest := idOrPattern.NamedChild(0); rest != nil {
+			decls = ts.scanDecl(rest, declarator, decls)
 		}
 	}
 
@@ -129,12 +194,17 @@ func (ts *TsScopeBuilder) variableFromImportSpecifier(specifier *sitter.Node) *V
 
 func (ts *TsScopeBuilder) CollectVariables(node *sitter.Node) []*Variable {
 	var declaredVars []*Variable
+	if isForInBinding(node) {
+		// for (const <binding> of ...) { ... }
+		return ts.scanDecl(node, node, declaredVars)
+	}
+
 	switch node.Type() {
 	case "variable_declarator":
 		lhs := node.ChildByFieldName("name")
 		return ts.scanDecl(lhs, node, declaredVars)
 
-	case "function_declaration":
+	case "function_declaration", "generator_function_declaration":
 		name := node.ChildByFieldName("name")
 		// skipcq: TCV-001
 		if name == nil {
@@ -148,7 +218,30 @@ func (ts *TsScopeBuilder) CollectVariables(node *sitter.Node) []*Variable {
 		})
 
 	case "formal_parameters":
-		// TODO
+		// function f(<params>) { ... }
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			param := node.NamedChild(i)
+			pattern := param.ChildByFieldName("pattern")
+			if pattern == nil {
+				continue
+			}
+
+			params := ts.scanDecl(pattern, param, nil)
+			for _, p := range params {
+				p.Kind = VarKindParameter
+			}
+
+			declaredVars = append(declaredVars, params...)
+		}
+
+	case "identifier":
+		if isArrowFunctionParam(node) {
+			declaredVars = append(declaredVars, &Variable{
+				Kind:     VarKindParameter,
+				Name:     node.Content(ts.source),
+				DeclNode: node,
+			})
+		}
 
 	case "import_specifier":
 		// import { <name> } from ...
@@ -170,6 +263,23 @@ func (ts *TsScopeBuilder) CollectVariables(node *sitter.Node) []*Variable {
 	return declaredVars
 }
 
+// isWriteRef returns true if the identifier `node` is the target of an assignment.
+// Writes through destructuring patterns (`[a] = xs`) are not tracked.
+func isWriteRef(node *sitter.Node) bool {
+	parent := node.Parent()
+	switch parent.Type() {
+	case "assignment_expression", "augmented_assignment_expression":
+		return parent.ChildByFieldName("left") == node
+	case "for_in_statement":
+		// `for (x of xs)` assigns to `x`, but `for (const x of xs)` declares a new one.
+		return parent.ChildByFieldName("left") == node && parent.ChildByFieldName("kind") == nil
+	case "update_expression":
+		return true
+	default:
+		return false
+	}
+}
+
 func (ts *TsScopeBuilder) OnNodeEnter(node *sitter.Node, scope *Scope) {
 	// collect identifier references if one is found
 	if node.Type() == "identifier" {
@@ -184,7 +294,17 @@ func (ts *TsScopeBuilder) OnNodeEnter(node *sitter.Node, scope *Scope) {
 			return
 		}
 
-		if parentType == "formal_parameters" {
+		if (parentType == "function_declaration" || parentType == "generator_function_declaration") &&
+			parent.ChildByFieldName("name") == node {
+			return
+		}
+
+		if parentType == "formal_parameters" || isArrowFunctionParam(node) || isForInBinding(node) {
+			return
+		}
+
+		// ...<rest> is a binding, not a reference
+		if parentType == "rest_pattern" {
 			return
 		}
 
@@ -226,8 +346,9 @@ func (ts *TsScopeBuilder) OnNodeEnter(node *sitter.Node, scope *Scope) {
 
 		// If a variable is found, add a reference to it
 		ref := &Reference{
-			Variable: variable,
-			Node:     node,
+			IsWriteRef: isWriteRef(node),
+			Variable:   variable,
+			Node:       node,
 		}
 		variable.Refs = append(variable.Refs, ref)
 	}
@@ -236,6 +357,7 @@ func (ts *TsScopeBuilder) OnNodeEnter(node *sitter.Node, scope *Scope) {
 func (ts *TsScopeBuilder) OnNodeExit(node *sitter.Node, scope *Scope) {
 	if node.Type() == "program" {
 		// At the end, try to resolve all unresolved references
+		resolved := map[*Variable]bool{}
 		for _, unresolved := range ts.unresolvedRefs {
 			variable := unresolved.surroundingScope.Lookup(
 				unresolved.id.Content(ts.source),
@@ -246,11 +368,20 @@ func (ts *TsScopeBuilder) OnNodeExit(node *sitter.Node, scope *Scope) {
 			}
 
 			ref := &Reference{
-				Variable: variable,
-				Node:     unresolved.id,
+				IsWriteRef: isWriteRef(unresolved.id),
+				Variable:   variable,
+				Node:       unresolved.id,
 			}
 
 			variable.Refs = append(variable.Refs, ref)
+			resolved[variable] = true
+		}
+
+		// late-resolved references are kept in source order too.
+		for variable := range resolved {
+			slices.SortStableFunc(variable.Refs, func(a, b *Reference) int {
+				return int(a.Node.StartByte()) - int(b.Node.StartByte())
+			})
 		}
 	}
 }
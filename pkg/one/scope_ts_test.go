@@ -89,4 +89,117 @@ func Test_BuildScopeTree(t *testing.T) {
 			assert.Equal(t, "call_expression", extnameRefs[1].Node.Parent().Type())
 		}
 	})
+
+	t.Run("supports function parameters", func(t *testing.T) {
+		source := `
+			function f(a, b = 1, { c }, ...d) { return a }
+			const g = x => x
+		`
+		parsed := parseFile(t, source)
+
+		scopeTree := MakeScopeTree(parsed.Language, parsed.Ast, parsed.Source)
+		require.NotNil(t, scopeTree)
+
+		fScope := scopeTree.Root.Children[0]
+		for _, name := range []string{"a", "b", "c", "d"} {
+			param, exists := fScope.Variables[name]
+			require.True(t, exists, name)
+			assert.Equal(t, VarKindParameter, param.Kind)
+		}
+
+		assert.Equal(t, 1, len(fScope.Variables["a"].Refs))
+		assert.Equal(t, 0, len(fScope.Variables["b"].Refs))
+
+		gScope := scopeTree.Root.Children[1]
+		varX, exists := gScope.Variables["x"]
+		require.True(t, exists)
+		assert.Equal(t, VarKindParameter, varX.Kind)
+		assert.Equal(t, 1, len(varX.Refs))
+	})
+
+	t.Run("declares functions", func(t *testing.T) {
+		source := `
+			f()
+			function f() { return g() }
+			function* g() {}
+		`
+		parsed := parseFile(t, source)
+
+		scopeTree := MakeScopeTree(parsed.Language, parsed.Ast, parsed.Source)
+		require.NotNil(t, scopeTree)
+
+		for _, name := range []string{"f", "g"} {
+			fn, exists := scopeTree.Root.Variables[name]
+			require.True(t, exists, name)
+			assert.Equal(t, VarKindFunction, fn.Kind)
+			assert.Equal(t, 1, len(fn.Refs), name)
+		}
+	})
+
+	t.Run("marks write references", func(t *testing.T) {
+		source := `
+			let x = 1
+			x = 2
+			x += 3
+			x++
+			f(x)
+			x.y = 4
+		`
+		parsed := parseFile(t, source)
+
+		scopeTree := MakeScopeTree(parsed.Language, parsed.Ast, parsed.Source)
+		require.NotNil(t, scopeTree)
+
+		var writes []bool
+		for _, ref := range scopeTree.Root.Variables["x"].Refs {
+			writes = append(writes, ref.IsWriteRef)
+		}
+
+		assert.Equal(t, []bool{true, true, true, false, false}, writes)
+	})
+
+	t.Run("declares destructured bindings with defaults and rest elements", func(t *testing.T) {
+		source := `
+			const { a = 1, b: c = 2, ...rest } = obj
+			const [d = 3, [e], ...others] = xs
+		`
+		parsed := parseFile(t, source)
+
+		scopeTree := MakeScopeTree(parsed.Language, parsed.Ast, parsed.Source)
+		require.NotNil(t, scopeTree)
+
+		var names []string
+		for name := range scopeTree.Root.Variables {
+			names = append(names, name)
+		}
+
+		assert.ElementsMatch(t, []string{"a", "c", "rest", "d", "e", "others"}, names)
+	})
+
+	t.Run("declares for-in and for-of bindings", func(t *testing.T) {
+		source := `
+			let x = 1
+			for (x of xs) {}
+			for (const x of xs) { f(x) }
+			for (let [a, { b }] in obj) {}
+		`
+		parsed := parseFile(t, source)
+
+		scopeTree := MakeScopeTree(parsed.Language, parsed.Ast, parsed.Source)
+		require.NotNil(t, scopeTree)
+
+		outer := scopeTree.Root.Variables["x"]
+		require.Equal(t, 1, len(outer.Refs))
+		assert.True(t, outer.Refs[0].IsWriteRef)
+
+		require.Equal(t, 3, len(scopeTree.Root.Children))
+		inner := scopeTree.Root.Children[1].Variables["x"]
+		require.NotNil(t, inner)
+		require.Equal(t, 1, len(inner.Refs))
+		assert.False(t, inner.Refs[0].IsWriteRef)
+
+		assert.NotNil(t, scopeTree.Root.Children[2].Variables["a"])
+		assert.NotNil(t, scopeTree.Root.Children[2].Variables["b"])
+		assert.NotContains(t, scopeTree.Root.Variables, "a")
+	})
 }
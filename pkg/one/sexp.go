@@ -0,0 +1,64 @@
+package one
+
+import (
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// SExpression serializes the parse tree into an S-expression.
+// The format is similar to that of `(*sitter.Node).String()`, except that
+// leaf nodes also contain their source text.
+// e.g: `let x = 1` becomes:
+//
+//	(program (lexical_declaration (variable_declarator name: (identifier "x") value: (number "1"))))
+//
+// Useful for snapshot tests, and for debugging why a rule didn't match.
+func (pr *ParseResult) SExpression() string {
+	var sb strings.Builder
+	writeSExpression(&sb, pr.Ast, pr.Source)
+	return sb.String()
+}
+
+// writeSExpression walks the tree with a cursor, like `walkWithCursor`,
+// so that deeply nested trees can't overflow the stack.
+func writeSExpression(sb *strings.Builder, root *sitter.Node, source []byte) {
+	cursor := sitter.NewTreeCursor(root)
+	defer cursor.Close()
+
+	for {
+		node := cursor.CurrentNode()
+		if node.IsNamed() {
+			if node != root {
+				sb.WriteString(" ")
+			}
+
+			if fieldName := cursor.CurrentFieldName(); fieldName != "" && node != root {
+				sb.WriteString(fieldName)
+				sb.WriteString(": ")
+			}
+
+			sb.WriteString("(")
+			sb.WriteString(node.Type())
+			// only leaf nodes have their source text written out.
+			if node.NamedChildCount() > 0 && cursor.GoToFirstChild() {
+				continue
+			}
+
+			sb.WriteString(" ")
+			sb.WriteString(strconv.Quote(node.Content(source)))
+			sb.WriteString(")")
+		}
+
+		// climb up until there is a sibling to move to,
+		// closing every ancestor on the way.
+		for !cursor.GoToNextSibling() {
+			if !cursor.GoToParent() {
+				return
+			}
+
+			sb.WriteString(")")
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package one
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SExpression(t *testing.T) {
+	parsed := parseFile(t, "let x = 1")
+	assert.Equal(t,
+		`(program (lexical_declaration (variable_declarator name: (identifier "x") value: (number "1"))))`,
+		parsed.SExpression(),
+	)
+
+	parsed = parseFile(t, `f("a")`)
+	assert.Equal(t,
+		`(program (expression_statement (call_expression function: (identifier "f") arguments: (arguments (string (string_fragment "a"))))))`,
+		parsed.SExpression(),
+	)
+}
+
+func Test_SExpressionOfDeeplyNestedTree(t *testing.T) {
+	const depth = 50_000
+	source := strings.Repeat("(", depth) + "x" + strings.Repeat(")", depth)
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+
+	parsed, err := ParseString(source, LangPy)
+	require.NoError(t, err)
+
+	sexp := parsed.SExpression()
+	assert.Equal(t, depth, strings.Count(sexp, "(parenthesized_expression"))
+	assert.True(t, strings.HasSuffix(sexp, `(identifier "x")`+strings.Repeat(")", depth+2)))
+}
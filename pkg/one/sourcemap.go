@@ -0,0 +1,219 @@
+package one
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// A SourceMap maps positions in a generated file (e.g: transpiled JavaScript)
+// back to the positions in the original sources that produced them.
+// See: https://sourcemaps.info/spec.html
+type SourceMap struct {
+	Version    int      `json:"version"`
+	File       string   `json:"file"`
+	SourceRoot string   `json:"sourceRoot"`
+	Sources    []string `json:"sources"`
+	Names      []string `json:"names"`
+	Mappings   string   `json:"mappings"`
+	// lines holds the decoded mappings of every line in the generated file,
+	// sorted by generated column.
+	lines [][]mapping
+}
+
+// mapping maps a column in a line of the generated file
+// to a position in one of the original sources.
+type mapping struct {
+	generatedColumn uint32
+	source          int
+	original        sitter.Point
+}
+
+const base64Digits = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+// decodeVLQ decodes the base64 VLQ values in a single segment of a "mappings" string.
+func decodeVLQ(segment string) ([]int, error) {
+	var values []int
+	value, shift := 0, 0
+	for i := 0; i < len(segment); i++ {
+		digit := strings.IndexByte(base64Digits, segment[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid base64 character %q in mapping", segment[i])
+		}
+
+		value += (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			continue
+		}
+
+		// the lowest bit is the sign
+		if value&1 != 0 {
+			values = append(values, -(value >> 1))
+		} else {
+			values = append(values, value>>1)
+		}
+
+		value, shift = 0, 0
+	}
+
+	if shift != 0 {
+		return nil, errors.New("truncated VLQ value in mapping")
+	}
+
+	return values, nil
+}
+
+// decodeMappings decodes the "mappings" field of a source map.
+// Apart from the generated column, which restarts on every line,
+// all fields are relative to the same field in the previous segment.
+func (sm *SourceMap) decodeMappings() error {
+	var source, originalLine, originalColumn int
+	for _, line := range strings.Split(sm.Mappings, ";") {
+		var mappings []mapping
+		generatedColumn := 0
+		for _, segment := range strings.Split(line, ",") {
+			if segment == "" {
+				continue
+			}
+
+			values, err := decodeVLQ(segment)
+			if err != nil {
+				return err
+			}
+
+			generatedColumn += values[0]
+			// segments with only a generated column don't map to any source.
+			if len(values) < 4 {
+				continue
+			}
+
+			source += values[1]
+			originalLine += values[2]
+			originalColumn += values[3]
+			if source < 0 || source >= len(sm.Sources) || generatedColumn < 0 || originalLine < 0 || originalColumn < 0 {
+				return fmt.Errorf("mapping %q is out of bounds", segment)
+			}
+
+			mappings = append(mappings, mapping{
+				generatedColumn: uint32(generatedColumn),
+				source:          source,
+				original:        sitter.Point{Row: uint32(originalLine), Column: uint32(originalColumn)},
+			})
+		}
+
+		sort.SliceStable(mappings, func(i, j int) bool {
+			return mappings[i].generatedColumn < mappings[j].generatedColumn
+		})
+
+		sm.lines = append(sm.lines, mappings)
+	}
+
+	return nil
+}
+
+// ParseSourceMap parses a source map in the JSON format (version 3).
+func ParseSourceMap(data []byte) (*SourceMap, error) {
+	var sm SourceMap
+	if err := json.Unmarshal(data, &sm); err != nil {
+		return nil, err
+	}
+
+	if sm.Version != 3 {
+		return nil, fmt.Errorf("unsupported source map version: %d", sm.Version)
+	}
+
+	if err := sm.decodeMappings(); err != nil {
+		return nil, err
+	}
+
+	return &sm, nil
+}
+
+// sourcePath returns the path of the i-th original source, including the source root.
+func (sm *SourceMap) sourcePath(i int) string {
+	if sm.SourceRoot == "" {
+		return sm.Sources[i]
+	}
+
+	return path.Join(sm.SourceRoot, sm.Sources[i])
+}
+
+// OriginalPosition returns the original source and position that the position `p`
+// in the generated file maps to. The closest mapping at or before `p` on the same line is used.
+// Returns false if nothing on that line maps to a source.
+// Columns are compared as-is, so they should be in the same unit that the source map uses (UTF-16 code units).
+func (sm *SourceMap) OriginalPosition(p sitter.Point) (source string, original sitter.Point, ok bool) {
+	if int(p.Row) >= len(sm.lines) {
+		return "", sitter.Point{}, false
+	}
+
+	mappings := sm.lines[p.Row]
+	i := sort.Search(len(mappings), func(i int) bool {
+		return mappings[i].generatedColumn > p.Column
+	})
+
+	if i == 0 {
+		return "", sitter.Point{}, false
+	}
+
+	m := mappings[i-1]
+	original = sitter.Point{Row: m.original.Row, Column: m.original.Column + p.Column - m.generatedColumn}
+	return sm.sourcePath(m.source), original, true
+}
+
+// translateRange maps `r`, a range in the generated source, to the original sources.
+// The end of the range is collapsed onto its start if it maps to a different source.
+// Byte offsets are cleared, since the original sources aren't available to compute them.
+func (sm *SourceMap) translateRange(generated []byte, lines []uint32, r sitter.Range) (string, sitter.Range, bool) {
+	source, start, ok := sm.OriginalPosition(utf16Point(generated, lines, r.StartPoint))
+	if !ok {
+		return "", r, false
+	}
+
+	end := start
+	if endSource, endPoint, ok := sm.OriginalPosition(utf16Point(generated, lines, r.EndPoint)); ok && endSource == source {
+		end = endPoint
+	}
+
+	return source, sitter.Range{StartPoint: start, EndPoint: end}, true
+}
+
+// TranslateIssues maps the issues raised in the generated file at `generatedPath`
+// back to the original sources, and returns them grouped by the original file (like `FormatByRule` expects).
+// The returned issues are copies. Issues that can't be mapped are kept as-is under `generatedPath`.
+// Fixes are dropped from translated issues, since they edit the generated file.
+// `generated` is the content of the generated file. It is used to convert the byte columns
+// of the issues to the UTF-16 columns that source maps use. The columns of the translated
+// issues are UTF-16 columns in the original sources.
+func TranslateIssues(generatedPath string, generated []byte, issues []*Issue, sm *SourceMap) map[string][]*Issue {
+	lines := lineOffsets(generated)
+	results := map[string][]*Issue{}
+	for _, issue := range issues {
+		source, r, ok := sm.translateRange(generated, lines, issue.Range)
+		if !ok {
+			results[generatedPath] = append(results[generatedPath], issue)
+			continue
+		}
+
+		translated := *issue
+		translated.Range = r
+		translated.Node = nil
+		translated.Fix = nil
+		translated.Related = nil
+		for _, related := range issue.Related {
+			if relatedSource, r, ok := sm.translateRange(generated, lines, related.Range); ok && relatedSource == source {
+				translated.Related = append(translated.Related, RelatedLocation{Message: related.Message, Range: r})
+			}
+		}
+
+		results[source] = append(results[source], &translated)
+	}
+
+	return results
+}
@@ -0,0 +1,126 @@
+package one
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DecodeVLQ(t *testing.T) {
+	values, err := decodeVLQ("AAgBC")
+	require.NoError(t, err)
+	// "gB" is a two digit value: 0 + (1 << 5) = 32, which is +16
+	assert.Equal(t, []int{0, 0, 16, 1}, values)
+
+	values, err = decodeVLQ("D")
+	require.NoError(t, err)
+	assert.Equal(t, []int{-1}, values)
+
+	_, err = decodeVLQ("g")
+	assert.Error(t, err)
+	_, err = decodeVLQ("A!")
+	assert.Error(t, err)
+}
+
+// a source map for:
+//
+//	line 0: `let a = 1;let b = 2;` generated from src/a.ts:0:0 and src/b.ts:4:2
+//	line 1: `foo();` generated from src/a.ts:1:4
+const testSourceMap = `{
+	"version": 3,
+	"file": "out.js",
+	"sourceRoot": "src",
+	"sources": ["a.ts", "b.ts"],
+	"names": [],
+	"mappings": "AAAA,UCIE;ADHE"
+}`
+
+func Test_SourceMap(t *testing.T) {
+	sm, err := ParseSourceMap([]byte(testSourceMap))
+	require.NoError(t, err)
+
+	source, pos, ok := sm.OriginalPosition(sitter.Point{Row: 0, Column: 4})
+	require.True(t, ok)
+	assert.Equal(t, "src/a.ts", source)
+	assert.Equal(t, sitter.Point{Row: 0, Column: 4}, pos)
+
+	source, pos, ok = sm.OriginalPosition(sitter.Point{Row: 0, Column: 14})
+	require.True(t, ok)
+	assert.Equal(t, "src/b.ts", source)
+	assert.Equal(t, sitter.Point{Row: 4, Column: 6}, pos)
+
+	source, pos, ok = sm.OriginalPosition(sitter.Point{Row: 1, Column: 0})
+	require.True(t, ok)
+	assert.Equal(t, "src/a.ts", source)
+	assert.Equal(t, sitter.Point{Row: 1, Column: 4}, pos)
+
+	_, _, ok = sm.OriginalPosition(sitter.Point{Row: 7, Column: 0})
+	assert.False(t, ok)
+
+	_, err = ParseSourceMap([]byte(`{"version": 2, "mappings": ""}`))
+	assert.Error(t, err)
+	_, err = ParseSourceMap([]byte(`{"version": 3, "sources": [], "mappings": "AAAA"}`))
+	assert.Error(t, err)
+}
+
+func Test_TranslateIssues(t *testing.T) {
+	sm, err := ParseSourceMap([]byte(testSourceMap))
+	require.NoError(t, err)
+
+	span := func(row, start, end uint32) sitter.Range {
+		return sitter.Range{
+			StartPoint: sitter.Point{Row: row, Column: start},
+			EndPoint:   sitter.Point{Row: row, Column: end},
+			StartByte:  start,
+			EndByte:    end,
+		}
+	}
+
+	inA := &Issue{Message: "a", Range: span(0, 4, 5), Fix: &Fix{}}
+	inB := &Issue{Message: "b", Range: span(0, 14, 15)}
+	// starts in a.ts, but ends in b.ts
+	spansFiles := &Issue{Message: "both", Range: span(0, 0, 15)}
+	unmapped := &Issue{Message: "unmapped", Range: span(9, 0, 1)}
+
+	generated := []byte("let a = 1;let b = 2;\nfoo();")
+	results := TranslateIssues("out.js", generated, []*Issue{inA, inB, spansFiles, unmapped}, sm)
+	require.Equal(t, 2, len(results["src/a.ts"]))
+	require.Equal(t, 1, len(results["src/b.ts"]))
+	assert.Equal(t, []*Issue{unmapped}, results["out.js"])
+
+	translated := results["src/a.ts"][0]
+	assert.Equal(t, sitter.Range{StartPoint: sitter.Point{Row: 0, Column: 4}, EndPoint: sitter.Point{Row: 0, Column: 5}}, translated.Range)
+	assert.Nil(t, translated.Fix)
+	// the original issue is left untouched
+	assert.Equal(t, uint32(4), inA.Range.StartByte)
+	assert.NotNil(t, inA.Fix)
+
+	assert.Equal(t, sitter.Point{Row: 4, Column: 6}, results["src/b.ts"][0].Range.StartPoint)
+
+	collapsed := results["src/a.ts"][1].Range
+	assert.Equal(t, collapsed.StartPoint, collapsed.EndPoint)
+}
+
+func Test_TranslateIssuesUtf16Columns(t *testing.T) {
+	sm, err := ParseSourceMap([]byte(testSourceMap))
+	require.NoError(t, err)
+
+	// 'é' is 2 bytes long, but a single UTF-16 code unit,
+	// so `let b` starts at byte 11 and UTF-16 column 10.
+	generated := []byte("let a='é';let b = 2;")
+	issue := &Issue{Message: "b", Range: sitter.Range{
+		StartPoint: sitter.Point{Row: 0, Column: 11},
+		EndPoint:   sitter.Point{Row: 0, Column: 16},
+	}}
+
+	results := TranslateIssues("out.js", generated, []*Issue{issue}, sm)
+	require.Equal(t, 1, len(results["src/b.ts"]))
+	translated := results["src/b.ts"][0].Range
+	assert.Equal(t, sitter.Point{Row: 4, Column: 2}, translated.StartPoint)
+	assert.Equal(t, sitter.Point{Row: 4, Column: 7}, translated.EndPoint)
+
+	// characters outside the BMP take two UTF-16 code units.
+	assert.Equal(t, sitter.Point{Row: 1, Column: 3}, utf16Point([]byte("x\n😀y"), []uint32{0, 2}, sitter.Point{Row: 1, Column: 5}))
+}
@@ -0,0 +1,116 @@
+package one
+
+import (
+	"bytes"
+	"regexp"
+	"slices"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// A Suppression is an `onelint-disable-line` or `onelint-disable-next-line`
+// comment, which suppresses issues raised on a single line:
+//
+//	eval(code) // onelint-disable-line js-no-eval
+//	// onelint-disable-next-line js-no-eval, js-no-console
+//	console.log(eval(code))
+//
+// When no rules are listed, issues from all rules are suppressed.
+type Suppression struct {
+	// Rules is the list of rules whose issues are suppressed.
+	// Empty if all rules are suppressed.
+	Rules []string
+	// Line is the (0-based) line on which issues are suppressed.
+	Line uint32
+	// Comment is the comment node that contains the directive.
+	Comment *sitter.Node
+	// used is the set of rules whose issues were suppressed by this comment.
+	used map[string]bool
+}
+
+// UnusedSuppressionRuleName is the `RuleName` of the issues
+// reported by `Analyzer.ReportUnusedSuppressions`.
+const UnusedSuppressionRuleName = "unused-suppression"
+
+var suppressionRegexp = regexp.MustCompile(`onelint-disable-(next-line|line)\b([^\n]*)`)
+
+// suppresses returns true if `issue` is silenced by the suppression `s`.
+func (s *Suppression) suppresses(issue *Issue) bool {
+	if issue.Range.StartPoint.Row != s.Line {
+		return false
+	}
+
+	return len(s.Rules) == 0 || slices.Contains(s.Rules, issue.RuleName)
+}
+
+func (s *Suppression) markUsed(rule string) {
+	if s.used == nil {
+		s.used = map[string]bool{}
+	}
+
+	s.used[rule] = true
+}
+
+// commentCollector is a Walker that collects all comment nodes.
+// Grammars name them differently (e.g: "comment", "line_comment"),
+// but they all have "comment" in their name.
+type commentCollector struct {
+	comments []*sitter.Node
+}
+
+func (c *commentCollector) OnEnterNode(node *sitter.Node) bool {
+	if strings.Contains(node.Type(), "comment") {
+		c.comments = append(c.comments, node)
+	}
+
+	return true
+}
+
+func (c *commentCollector) OnLeaveNode(node *sitter.Node) {}
+
+// parseSuppression returns the suppression in the comment `text`, if any.
+func parseSuppression(text string) (rules []string, nextLine bool, ok bool) {
+	match := suppressionRegexp.FindStringSubmatch(text)
+	if match == nil {
+		return nil, false, false
+	}
+
+	list := strings.TrimSpace(match[2])
+	list = strings.TrimSpace(strings.TrimSuffix(strings.TrimSuffix(list, "*/"), "-->"))
+	for _, rule := range strings.Split(list, ",") {
+		if rule = strings.TrimSpace(rule); rule != "" {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules, match[1] == "next-line", true
+}
+
+// Suppressions returns all the suppression comments in the file, in source order.
+func (pr *ParseResult) Suppressions() []*Suppression {
+	// most files have none, so skip walking the tree for them.
+	if !bytes.Contains(pr.Source, []byte("onelint-disable")) {
+		return nil
+	}
+
+	collector := &commentCollector{}
+	WalkTree(pr.Ast, collector)
+
+	var suppressions []*Suppression
+	for _, comment := range collector.comments {
+		rules, nextLine, ok := parseSuppression(comment.Content(pr.Source))
+		if !ok {
+			continue
+		}
+
+		line := comment.StartPoint().Row
+		if nextLine {
+			line = comment.EndPoint().Row + 1
+		}
+
+		suppressions = append(suppressions, &Suppression{Rules: rules, Line: line, Comment: comment})
+	}
+
+	return suppressions
+}
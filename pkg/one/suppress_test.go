@@ -0,0 +1,92 @@
+package one
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Suppressions(t *testing.T) {
+	parsed := parseFile(t, `
+		a // onelint-disable-line
+		// onelint-disable-next-line report-all-identifier, other-rule
+		b
+		/* onelint-disable-line other-rule */ c
+		d`)
+
+	suppressions := parsed.Suppressions()
+	require.Equal(t, 3, len(suppressions))
+	assert.Empty(t, suppressions[0].Rules)
+	assert.Equal(t, uint32(1), suppressions[0].Line)
+	assert.Equal(t, []string{"report-all-identifier", "other-rule"}, suppressions[1].Rules)
+	assert.Equal(t, uint32(3), suppressions[1].Line)
+	assert.Equal(t, []string{"other-rule"}, suppressions[2].Rules)
+
+	issues := NewAnalyzer(parsed, []Rule{reportAll("identifier")}).Analyze()
+	var messages []string
+	for _, issue := range issues {
+		messages = append(messages, issue.Message)
+	}
+
+	assert.Equal(t, []string{"c", "d"}, messages)
+}
+
+func Test_SuppressionsInOtherLanguages(t *testing.T) {
+	parsed := parseAs(t, LangPy, "x = 1  # onelint-disable-line\ny = 2")
+	suppressions := parsed.Suppressions()
+	require.Equal(t, 1, len(suppressions))
+	assert.Equal(t, uint32(0), suppressions[0].Line)
+
+	assert.Empty(t, parseAs(t, LangPy, "# onelint-disabled\nx = 1").Suppressions())
+}
+
+func Test_ReportUnusedSuppressions(t *testing.T) {
+	parsed := parseFile(t, `
+		a // onelint-disable-line
+		1 // onelint-disable-line
+		// onelint-disable-next-line report-all-identifier, other-rule, not-enabled
+		b
+		// onelint-disable-next-line other-rule
+		c`)
+
+	rules := []Rule{reportAll("identifier"), CreateRule("other-rule", "program", LangJs, nil, nil)}
+	analyzer := NewAnalyzer(parsed, rules)
+
+	// off by default
+	for _, issue := range analyzer.Analyze() {
+		assert.NotEqual(t, UnusedSuppressionRuleName, issue.RuleName)
+	}
+
+	analyzer = NewAnalyzer(parsed, rules)
+	analyzer.ReportUnusedSuppressions = true
+	var unused []*Issue
+	for _, issue := range analyzer.Analyze() {
+		if issue.RuleName == UnusedSuppressionRuleName {
+			unused = append(unused, issue)
+		}
+	}
+
+	require.Len(t, unused, 3)
+	assert.Equal(t, "Unused suppression comment: no issues were suppressed", unused[0].Message)
+	assert.Equal(t, "// onelint-disable-line", unused[0].Node.Content(parsed.Source))
+	assert.Equal(t, uint32(2), unused[0].Range.StartPoint.Row)
+
+	assert.Equal(t, "Unused suppression comment: no issues from 'other-rule' were suppressed", unused[1].Message)
+	assert.Equal(t, uint32(3), unused[1].Range.StartPoint.Row)
+
+	assert.Equal(t, "Unused suppression comment: no issues from 'other-rule' were suppressed", unused[2].Message)
+	assert.Equal(t, uint32(5), unused[2].Range.StartPoint.Row)
+
+	// disabled rules aren't expected to raise issues
+	analyzer = NewAnalyzer(parsed, rules)
+	analyzer.ReportUnusedSuppressions = true
+	analyzer.Disable("other-rule")
+	count := 0
+	for _, issue := range analyzer.Analyze() {
+		if issue.RuleName == UnusedSuppressionRuleName {
+			count++
+		}
+	}
+	assert.Equal(t, 1, count)
+}
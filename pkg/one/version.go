@@ -0,0 +1,84 @@
+package one
+
+import (
+	"path"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// Version is the version of onelint.
+const Version = "0.1.0"
+
+// packageOf returns the import path of the package that defines the function `fn`.
+func packageOf(fn any) string {
+	f := runtime.FuncForPC(reflect.ValueOf(fn).Pointer())
+	if f == nil {
+		return ""
+	}
+
+	// e.g: "github.com/smacker/go-tree-sitter/python.GetLanguage"
+	name := f.Name()
+	slash := max(strings.LastIndex(name, "/"), 0)
+	if dot := strings.Index(name[slash:], "."); dot >= 0 {
+		return name[:slash+dot]
+	}
+
+	return name
+}
+
+// moduleVersion returns the version of the module that provides the package `pkg`,
+// or an empty string if it isn't a dependency of the binary.
+func moduleVersion(info *debug.BuildInfo, pkg string) string {
+	for _, dep := range info.Deps {
+		if pkg != dep.Path && !strings.HasPrefix(pkg, dep.Path+"/") {
+			continue
+		}
+
+		if dep.Replace != nil {
+			dep = dep.Replace
+		}
+
+		return dep.Version
+	}
+
+	return ""
+}
+
+// BuildInfo returns the versions of onelint and the libraries that affect its results,
+// so that results can be traced back to the build that produced them.
+// The keys are:
+//   - "onelint": the version of onelint, i.e: `Version`.
+//   - "go": the version of Go the binary was built with.
+//   - "tree-sitter": the version of the tree-sitter bindings.
+//   - "grammar/<name>": the version of every linked grammar, e.g: "grammar/python".
+//
+// Versions of dependencies are only included when the binary was
+// built with module support, and the version is known.
+func BuildInfo() map[string]string {
+	info := map[string]string{
+		"onelint": Version,
+		"go":      runtime.Version(),
+	}
+
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	if version := moduleVersion(buildInfo, packageOf(sitter.NewParser)); version != "" {
+		info["tree-sitter"] = version
+	}
+
+	for _, lang := range SupportedLanguages() {
+		pkg := packageOf(languages[lang].grammar)
+		if version := moduleVersion(buildInfo, pkg); version != "" {
+			info["grammar/"+path.Base(pkg)] = version
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,20 @@
+package one
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_BuildInfo(t *testing.T) {
+	info := BuildInfo()
+	assert.Equal(t, Version, info["onelint"])
+	assert.NotEmpty(t, info["go"])
+
+	// all grammars are bundled with the tree-sitter bindings.
+	bindings := info["tree-sitter"]
+	require.NotEmpty(t, bindings)
+	assert.Equal(t, bindings, info["grammar/python"])
+	assert.Equal(t, bindings, info["grammar/tsx"])
+}
@@ -1,6 +1,11 @@
 package one
 
 import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
 	sitter "github.com/smacker/go-tree-sitter"
 )
 
@@ -18,16 +23,44 @@ type Walker interface {
 	OnLeaveNode(node *sitter.Node)
 }
 
+// WalkTree walks the sub-tree rooted at `node`, visiting
+// only named nodes. Anonymous nodes, like punctuation and keywords,
+// are skipped along with their sub-trees.
 func WalkTree(node *sitter.Node, walker Walker) {
-	goInside := walker.OnEnterNode(node)
-	if goInside {
-		for i := 0; i < int(node.NamedChildCount()); i++ {
-			child := node.NamedChild(i)
-			WalkTree(child, walker)
+	walkWithCursor(node, walker, false)
+}
+
+// WalkAll is like WalkTree, but also visits anonymous nodes (e.g: `async`, `+`, `;`).
+func WalkAll(node *sitter.Node, walker Walker) {
+	walkWithCursor(node, walker, true)
+}
+
+// walkWithCursor walks the tree iteratively with a tree cursor,
+// so that deeply nested trees (e.g: from adversarial input) can't overflow the stack.
+func walkWithCursor(root *sitter.Node, walker Walker, visitAnonymous bool) {
+	cursor := sitter.NewTreeCursor(root)
+	defer cursor.Close()
+
+	for {
+		node := cursor.CurrentNode()
+		if node.IsNamed() || visitAnonymous {
+			if walker.OnEnterNode(node) && cursor.GoToFirstChild() {
+				continue
+			}
+
+			walker.OnLeaveNode(node)
 		}
-	}
 
-	walker.OnLeaveNode(node)
+		// climb up until there is a sibling to move to,
+		// leaving every ancestor on the way.
+		for !cursor.GoToNextSibling() {
+			if !cursor.GoToParent() {
+				return
+			}
+
+			walker.OnLeaveNode(cursor.CurrentNode())
+		}
+	}
 }
 
 // ChildrenWithFieldName returns all the children of a node
@@ -94,3 +127,67 @@ func FirstChildOfType(node *sitter.Node, nodeType string) *sitter.Node {
 
 	return nil
 }
+
+// nodeCollector is a Walker that collects all nodes of a given type.
+type nodeCollector struct {
+	nodeType string
+	nodes    []*sitter.Node
+}
+
+func (c *nodeCollector) OnEnterNode(node *sitter.Node) bool {
+	if node.Type() == c.nodeType {
+		c.nodes = append(c.nodes, node)
+	}
+	return true
+}
+
+func (c *nodeCollector) OnLeaveNode(node *sitter.Node) {}
+
+// FindAll returns all the (named) nodes of a given type in the parse tree,
+// in the order in which they appear in the source.
+func (pr *ParseResult) FindAll(nodeType string) []*sitter.Node {
+	collector := &nodeCollector{nodeType: nodeType}
+	WalkTree(pr.Ast, collector)
+	return collector.nodes
+}
+
+// NodePath returns the position of `node` in the parse tree as a path of
+// (named) child indices from the root, e.g: "0/2/1".
+// An anonymous node, like an operator, ends the path with its type and its index
+// among the siblings of the same type instead, e.g: "0/2/+#0".
+// The path only depends on the structure of the tree, so it stays
+// the same when the source is reformatted.
+// Returns an empty string for the root node.
+func (pr *ParseResult) NodePath(node *sitter.Node) string {
+	var indices []string
+	for node != pr.Ast {
+		parent := node.Parent()
+		if parent == nil {
+			break
+		}
+
+		named, sameType := 0, 0
+		for i := 0; i < int(parent.ChildCount()); i++ {
+			child := parent.Child(i)
+			if child == node {
+				if node.IsNamed() {
+					indices = append(indices, strconv.Itoa(named))
+				} else {
+					indices = append(indices, fmt.Sprintf("%s#%d", node.Type(), sameType))
+				}
+				break
+			}
+
+			if child.IsNamed() {
+				named++
+			} else if child.Type() == node.Type() {
+				sameType++
+			}
+		}
+
+		node = parent
+	}
+
+	slices.Reverse(indices)
+	return strings.Join(indices, "/")
+}
@@ -0,0 +1,149 @@
+package one
+
+import (
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FindAll(t *testing.T) {
+	source := `
+		function f() {
+			let a = 1
+			if (a) { let b = 2 }
+		}
+		let c = 3`
+	parsed := parseFile(t, source)
+
+	declarators := parsed.FindAll("variable_declarator")
+	require.Equal(t, 3, len(declarators))
+	assert.Equal(t, "a = 1", declarators[0].Content(parsed.Source))
+	assert.Equal(t, "b = 2", declarators[1].Content(parsed.Source))
+	assert.Equal(t, "c = 3", declarators[2].Content(parsed.Source))
+
+	assert.Empty(t, parsed.FindAll("class_declaration"))
+}
+
+func Test_NodePath(t *testing.T) {
+	pathOf := func(source, nodeType string) string {
+		parsed := parseFile(t, source)
+		nodes := parsed.FindAll(nodeType)
+		require.Equal(t, 1, len(nodes))
+		return parsed.NodePath(nodes[0])
+	}
+
+	path := pathOf("foo(); if (x) { bar(1) }", "number")
+	assert.Equal(t, "1/1/0/0/1/0", path)
+
+	// reformatting doesn't change the path
+	assert.Equal(t, path, pathOf("foo()\n\nif (x) {\n\tbar( 1 );\n}", "number"))
+
+	parsed := parseFile(t, "x")
+	assert.Equal(t, "", parsed.NodePath(parsed.Ast))
+
+	// anonymous nodes don't share the path of their parent, or of each other
+	parsed = parseFile(t, "[a, b, c]")
+	array := parsed.FindAll("array")[0]
+	var paths []string
+	for i := 0; i < int(array.ChildCount()); i++ {
+		paths = append(paths, parsed.NodePath(array.Child(i)))
+	}
+
+	assert.Equal(t, []string{"0/0/[#0", "0/0/0", "0/0/,#0", "0/0/1", "0/0/,#1", "0/0/2", "0/0/]#0"}, paths)
+	assert.Equal(t, "0/0", parsed.NodePath(array))
+}
+
+// nodeCounter counts the nodes visited in a walk.
+type nodeCounter struct{ visits int }
+
+func (c *nodeCounter) OnEnterNode(node *sitter.Node) bool {
+	c.visits++
+	return true
+}
+
+func (c *nodeCounter) OnLeaveNode(node *sitter.Node) {}
+
+const walkBenchSource = `
+	import { readFile } from "fs"
+	async function load(path, opts = {}) {
+		const data = await readFile(path, "utf-8")
+		if (opts.json && data.length > 0) {
+			return JSON.parse(data)
+		}
+		return data.split("\n").map((line) => line.trim())
+	}`
+
+func Test_WalkAll(t *testing.T) {
+	parsed := parseFile(t, walkBenchSource)
+
+	named, all := &nodeCounter{}, &nodeCounter{}
+	WalkTree(parsed.Ast, named)
+	WalkAll(parsed.Ast, all)
+	assert.Less(t, named.visits, all.visits)
+
+	// rules that register for keywords only run when the analyzer visits anonymous nodes.
+	analyzer := NewAnalyzer(parsed, []Rule{reportAll("async")})
+	assert.Empty(t, analyzer.Analyze())
+
+	analyzer.VisitAnonymousNodes = true
+	issues := analyzer.Analyze()
+	require.Equal(t, 1, len(issues))
+	assert.Equal(t, "async", issues[0].Message)
+}
+
+func BenchmarkWalk(b *testing.B) {
+	for _, bench := range []struct {
+		name string
+		walk func(*sitter.Node, Walker)
+	}{
+		{"named", WalkTree},
+		{"all", WalkAll},
+	} {
+		b.Run(bench.name, func(b *testing.B) {
+			parsed, err := ParseString(walkBenchSource, LangJs)
+			require.NoError(b, err)
+
+			counter := &nodeCounter{}
+			for i := 0; i < b.N; i++ {
+				counter.visits = 0
+				bench.walk(parsed.Ast, counter)
+			}
+
+			b.ReportMetric(float64(counter.visits), "callbacks/op")
+		})
+	}
+}
+
+func Test_WalkDeeplyNestedTree(t *testing.T) {
+	const depth = 50_000
+	source := strings.Repeat("(", depth) + "x" + strings.Repeat(")", depth)
+
+	// a recursive walk needs far more than this to visit every level of the tree.
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+
+	// JS files also get a scope tree, which is built with the same iterative walk.
+	// Python has no scope builder yet.
+	// NOTE: the analyzer and the scope builder don't recurse, but rules that inspect
+	// sub-trees recursively (e.g: to compare expressions) may still overflow on input like this.
+	for _, lang := range []Language{LangPy, LangJs} {
+		parsed, err := ParseString(source, lang)
+		require.NoError(t, err, lang.String())
+
+		counter := &nodeCounter{}
+		WalkTree(parsed.Ast, counter)
+		assert.Greater(t, counter.visits, depth, lang.String())
+
+		if lang == LangJs {
+			require.NotNil(t, parsed.ScopeTree)
+			assert.Empty(t, parsed.ScopeTree.Root.Children)
+		}
+
+		issues := NewAnalyzer(parsed, []Rule{reportAll("identifier")}).Analyze()
+		require.Equal(t, 1, len(issues), lang.String())
+		assert.Equal(t, "x", issues[0].Message)
+	}
+}
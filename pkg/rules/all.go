@@ -1,18 +1,27 @@
 package rules
 
 import (
+	"slices"
+
 	"github.com/srijan-paul/deepgrep/pkg/one"
 	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
 	python_rules "github.com/srijan-paul/deepgrep/pkg/rules/python"
+	text_rules "github.com/srijan-paul/deepgrep/pkg/rules/text"
 )
 
-// CreateRules creates a base ruleset for each supported language
+// CreateRules creates a base ruleset for each supported language.
+// Only rules that report likely mistakes are part of it. Rules that enforce a style,
+// a size or complexity limit, or a project-specific convention are opt-in, and so are
+// rules that would report the same problems as a default rule
+// (see `js_rules.CreateJsRules` for a list).
 func CreateRules() map[one.Language][]one.Rule {
-	jsRules := js_rules.CreateJsRules()
+	jsRules := slices.Concat(js_rules.CreateJsRules(), text_rules.CreateTextRules(one.LangJs))
+	tsRules := slices.Concat(jsRules, js_rules.CreateTsRules())
+	pyRules := slices.Concat(python_rules.CreatePyRules(), text_rules.CreateTextRules(one.LangPy))
 	return map[one.Language][]one.Rule{
-		one.LangPy: python_rules.CreatePyRules(),
-		one.LangJs: jsRules,
-		one.LangTsx: jsRules,
-		one.LangTs: jsRules,
+		one.LangPy:  pyRules,
+		one.LangJs:  jsRules,
+		one.LangTsx: tsRules,
+		one.LangTs:  tsRules,
 	}
 }
@@ -0,0 +1,165 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type CognitiveComplexityOptions struct {
+	// Max is the highest cognitive complexity a function can have.
+	// Defaults to 15 when 0.
+	Max int
+}
+
+const defaultMaxCognitiveComplexity = 15
+
+var logicalOperators = []string{"&&", "||", "??"}
+
+// isLogicalExpression returns true for binary expressions like `a && b`,
+// and sets `operator` to the operator.
+func isLogicalExpression(node *sitter.Node) (operator string, ok bool) {
+	if node == nil || node.Type() != "binary_expression" {
+		return "", false
+	}
+
+	operator = operatorOf(node)
+	return operator, slices.Contains(logicalOperators, operator)
+}
+
+// ifComplexity computes the cognitive complexity of an if statement, and the else-if chain after it.
+// An `else if` is part of the chain, so unlike a nested if, it isn't penalized for nesting.
+func ifComplexity(ifStmt *sitter.Node, nesting int, isElseIf bool) int {
+	score := 1 + nesting
+	if isElseIf {
+		score = 1
+	}
+
+	if condition := ifStmt.ChildByFieldName("condition"); condition != nil {
+		score += cognitiveComplexity(condition, nesting)
+	}
+
+	if consequence := ifStmt.ChildByFieldName("consequence"); consequence != nil {
+		score += cognitiveComplexity(consequence, nesting+1)
+	}
+
+	elseClause := ifStmt.ChildByFieldName("alternative")
+	if elseClause == nil || elseClause.NamedChildCount() == 0 {
+		return score
+	}
+
+	body := elseClause.NamedChild(0)
+	if body.Type() == "if_statement" {
+		return score + ifComplexity(body, nesting, true)
+	}
+
+	return score + 1 + cognitiveComplexity(body, nesting+1)
+}
+
+// cognitiveComplexity computes the cognitive complexity of the sub-tree at `node`, following
+// https://www.sonarsource.com/docs/CognitiveComplexity.pdf:
+//   - Every break in the linear flow (if, else, loops, switch, catch, ternaries, labeled jumps)
+//     adds one to the score.
+//   - Structures that are nested in others add their nesting level on top of that.
+//     Functions increase the nesting level too, but are free otherwise.
+//   - Every sequence of the same boolean operator adds one, so `a && b && c` costs
+//     as much as `a && b`, but `a && b || c` costs two.
+func cognitiveComplexity(node *sitter.Node, nesting int) int {
+	score := 0
+	childNesting := nesting
+
+	switch node.Type() {
+	case "if_statement":
+		return ifComplexity(node, nesting, false)
+
+	case "ternary_expression", "switch_statement", "for_statement", "for_in_statement",
+		"while_statement", "do_statement", "catch_clause":
+		score += 1 + nesting
+		childNesting = nesting + 1
+
+	case "break_statement", "continue_statement":
+		if node.ChildByFieldName("label") != nil {
+			score++
+		}
+
+	case "binary_expression":
+		operator, ok := isLogicalExpression(node)
+		if !ok {
+			break
+		}
+
+		parent := node.Parent()
+		for parent != nil && parent.Type() == "parenthesized_expression" {
+			parent = parent.Parent()
+		}
+
+		if parentOperator, ok := isLogicalExpression(parent); !ok || parentOperator != operator {
+			score++
+		}
+
+	default:
+		if slices.Contains(functionNodeTypes, node.Type()) {
+			childNesting = nesting + 1
+		}
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		score += cognitiveComplexity(node.NamedChild(i), childNesting)
+	}
+
+	return score
+}
+
+func checkCognitiveComplexity(opts *CognitiveComplexityOptions, ana *one.Analyzer, fn *sitter.Node) {
+	// nested functions count towards the complexity of the function they're in.
+	if nearestFunction(fn) != nil {
+		return
+	}
+
+	body := fn.ChildByFieldName("body")
+	if body == nil {
+		return
+	}
+
+	max := opts.Max
+	if max == 0 {
+		max = defaultMaxCognitiveComplexity
+	}
+
+	score := cognitiveComplexity(body, 0)
+	if score <= max {
+		return
+	}
+
+	issue := &one.Issue{
+		Message: fmt.Sprintf("Function has a cognitive complexity of %d, which exceeds the maximum of %d", score, max),
+		Range:   fn.Range(),
+		Node:    fn,
+	}
+
+	if name := functionNameNode(fn); name != nil {
+		issue.Message = fmt.Sprintf("'%s' has a cognitive complexity of %d, which exceeds the maximum of %d",
+			name.Content(ana.ParseResult.Source), score, max)
+		issue.Range = name.Range()
+	}
+
+	ana.Report(issue)
+}
+
+// CognitiveComplexity flags functions that are hard to follow, measured by their
+// cognitive complexity. Unlike cyclomatic complexity, it penalizes nested control flow
+// more than flat control flow.
+// When `opts` is nil, the default options are used.
+func CognitiveComplexity(opts *CognitiveComplexityOptions) one.Rule {
+	if opts == nil {
+		opts = &CognitiveComplexityOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkCognitiveComplexity(opts, ana, node)
+	}
+
+	return one.CreateMultiNodeRule("js-cognitive-complexity", functionNodeTypes, one.LangJs, &entry, nil)
+}
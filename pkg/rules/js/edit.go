@@ -0,0 +1,58 @@
+package js_rules
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+)
+
+// indentationOf returns the leading whitespace of the line on which `node` starts.
+func indentationOf(node *sitter.Node, source []byte) string {
+	lineStart := int(node.StartByte()) - int(node.StartPoint().Column)
+	line := source[lineStart:]
+	end := 0
+	for end < len(line) && (line[end] == ' ' || line[end] == '\t') {
+		end++
+	}
+
+	return string(line[:end])
+}
+
+// reindent returns the source text spanning the nodes `first` through `last`,
+// with every line re-indented to `indent`, preserving relative indentation.
+func reindent(first, last *sitter.Node, indent string, source []byte) string {
+	lineStart := int(first.StartByte()) - int(first.StartPoint().Column)
+	prefix := source[lineStart:first.StartByte()]
+
+	var lines []string
+	if strings.TrimSpace(string(prefix)) == "" {
+		lines = strings.Split(string(source[lineStart:last.EndByte()]), "\n")
+	} else {
+		// `first` isn't at the start of its line. e.g: `else { foo() }`
+		lines = strings.Split(first.Content(source)+string(source[first.EndByte():last.EndByte()]), "\n")
+	}
+
+	// the smallest indentation among all non-blank lines is removed
+	minIndent := -1
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		lineIndent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if minIndent == -1 || lineIndent < minIndent {
+			minIndent = lineIndent
+		}
+	}
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+			continue
+		}
+
+		lines[i] = indent + line[minIndent:]
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,169 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type ImportOrderOptions struct {
+	// Internal is a list of patterns for modules that are internal to the project,
+	// e.g: "@myapp/**". Imports of these modules are grouped after external packages.
+	Internal one.PathPatterns
+}
+
+type importGroup int
+
+const (
+	importGroupBuiltin importGroup = iota
+	importGroupExternal
+	importGroupInternal
+	importGroupRelative
+)
+
+var nodeBuiltinModules = []string{
+	"assert", "buffer", "child_process", "cluster", "crypto", "dgram", "dns",
+	"events", "fs", "http", "http2", "https", "net", "os", "path", "perf_hooks",
+	"process", "querystring", "readline", "stream", "string_decoder", "timers",
+	"tls", "tty", "url", "util", "v8", "vm", "worker_threads", "zlib",
+}
+
+// importSource returns the module that an import statement imports from,
+// without the quotes.
+func importSource(importStmt *sitter.Node, source []byte) string {
+	src := importStmt.ChildByFieldName("source")
+	if src == nil {
+		return ""
+	}
+
+	text := src.Content(source)
+	return text[1 : len(text)-1]
+}
+
+func groupOfModule(module string, opts *ImportOrderOptions) importGroup {
+	if strings.HasPrefix(module, ".") {
+		return importGroupRelative
+	}
+
+	if opts.Internal.Match(module) {
+		return importGroupInternal
+	}
+
+	base, _, _ := strings.Cut(strings.TrimPrefix(module, "node:"), "/")
+	if strings.HasPrefix(module, "node:") || slices.Contains(nodeBuiltinModules, base) {
+		return importGroupBuiltin
+	}
+
+	return importGroupExternal
+}
+
+type orderedImport struct {
+	node   *sitter.Node
+	module string
+	group  importGroup
+}
+
+func (a orderedImport) before(b orderedImport) bool {
+	if a.group != b.group {
+		return a.group < b.group
+	}
+
+	return a.module < b.module
+}
+
+// reorderImportsFix returns a fix that sorts all imports in the file,
+// or nil if the imports can't be safely moved around.
+func reorderImportsFix(imports []orderedImport, source []byte) *one.Fix {
+	first, last := imports[0].node, imports[len(imports)-1].node
+
+	// every statement between the first and last import must be an import,
+	// and they can't have side effects (e.g: `import "polyfill"`)
+	for stmt := first; stmt != nil; stmt = stmt.NextNamedSibling() {
+		if stmt.Type() != "import_statement" || stmt.ChildByFieldName("source") == nil ||
+			one.FirstChildOfType(stmt, "import_clause") == nil {
+			return nil
+		}
+		if stmt == last {
+			break
+		}
+	}
+
+	sorted := slices.Clone(imports)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].before(sorted[j]) })
+
+	texts := make([]string, 0, len(sorted))
+	for _, imp := range sorted {
+		texts = append(texts, imp.node.Content(source))
+	}
+
+	return &one.Fix{
+		Range: sitter.Range{
+			StartPoint: first.StartPoint(),
+			EndPoint:   last.EndPoint(),
+			StartByte:  first.StartByte(),
+			EndByte:    last.EndByte(),
+		},
+		Replacement: strings.Join(texts, "\n"),
+	}
+}
+
+func checkImportOrder(opts *ImportOrderOptions, ana *one.Analyzer, root *sitter.Node) {
+	source := ana.ParseResult.Source
+
+	var imports []orderedImport
+	for _, importStmt := range one.ChildrenOfType(root, "import_statement") {
+		// side effect imports (`import "polyfill"`) are order-sensitive, so they're left alone
+		if one.FirstChildOfType(importStmt, "import_clause") == nil {
+			continue
+		}
+
+		module := importSource(importStmt, source)
+		imports = append(imports, orderedImport{
+			node:   importStmt,
+			module: module,
+			group:  groupOfModule(module, opts),
+		})
+	}
+
+	for i, imp := range imports {
+		// find the first import that this one should have been placed before
+		j := slices.IndexFunc(imports[:i], func(prev orderedImport) bool {
+			return imp.before(prev)
+		})
+
+		if j == -1 {
+			continue
+		}
+
+		ana.Report(&one.Issue{
+			Message: fmt.Sprintf("Import of '%s' should come before import of '%s'", imp.module, imports[j].module),
+			Range:   imp.node.Range(),
+			Related: []one.RelatedLocation{{
+				Message: fmt.Sprintf("'%s' should be imported before this", imp.module),
+				Range:   imports[j].node.Range(),
+			}},
+			Fix: reorderImportsFix(imports, source),
+		})
+		return
+	}
+}
+
+// ImportOrder checks that imports are grouped (builtin modules, external packages,
+// internal modules, and relative paths) and sorted alphabetically within each group.
+// Only the first out-of-order import is reported.
+// When `opts` is nil, the default options are used.
+func ImportOrder(opts *ImportOrderOptions) one.Rule {
+	if opts == nil {
+		opts = &ImportOrderOptions{}
+	}
+
+	var exit one.VisitFn = func(r one.Rule, ana *one.Analyzer, root *sitter.Node) {
+		checkImportOrder(opts, ana, root)
+	}
+
+	return one.CreateRule("js-import-order", one.FileNodeType, one.LangJs, nil, &exit)
+}
@@ -0,0 +1,92 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type MaxFileImportsOptions struct {
+	// Max is the maximum number of import statements a file can have.
+	// Defaults to 10 when 0.
+	Max int
+	// IgnoreTypeImports excludes `import type ...` statements from the count.
+	IgnoreTypeImports bool
+}
+
+const defaultMaxFileImports = 10
+
+func isTypeOnlyImport(node *sitter.Node) bool {
+	for i := 0; i < int(node.ChildCount()); i++ {
+		child := node.Child(i)
+		if !child.IsNamed() && child.Type() == "type" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func countImport(opts *MaxFileImportsOptions, countKey string, ana *one.Analyzer, node *sitter.Node) {
+	// the file hook runs with the root node.
+	if node.Type() != "import_statement" {
+		ana.Set(countKey, 0)
+		return
+	}
+
+	if opts.IgnoreTypeImports && isTypeOnlyImport(node) {
+		return
+	}
+
+	value, _ := ana.Get(countKey)
+	count, _ := value.(int)
+	ana.Set(countKey, count+1)
+}
+
+func checkFileImports(opts *MaxFileImportsOptions, countKey string, ana *one.Analyzer, node *sitter.Node) {
+	if node.Type() == "import_statement" {
+		return
+	}
+
+	max := opts.Max
+	if max == 0 {
+		max = defaultMaxFileImports
+	}
+
+	value, _ := ana.Get(countKey)
+	count, _ := value.(int)
+	if count <= max {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("File has %d imports. Maximum allowed is %d", count, max),
+		Range:   sitter.Range{},
+	})
+}
+
+// MaxFileImports flags files with more import statements than the configured maximum.
+// When `opts` is nil, the default options are used.
+func MaxFileImports(opts *MaxFileImportsOptions) one.Rule {
+	if opts == nil {
+		opts = &MaxFileImportsOptions{}
+	}
+
+	countKey := instanceKey("js-max-file-imports/count")
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		countImport(opts, countKey, ana, node)
+	}
+
+	var exit one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkFileImports(opts, countKey, ana, node)
+	}
+
+	return one.CreateMultiNodeRule(
+		"js-max-file-imports",
+		[]string{one.FileNodeType, "import_statement"},
+		one.LangJs,
+		&entry,
+		&exit,
+	)
+}
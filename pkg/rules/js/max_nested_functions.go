@@ -0,0 +1,110 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type MaxNestedFunctionsOptions struct {
+	// Max is the maximum depth that functions can be nested to.
+	// A top-level function has a depth of 1.
+	// Defaults to 3 when 0.
+	Max int
+	// IgnoreArrowFunctions doesn't count arrow functions towards the depth,
+	// so that callbacks like `xs.map(x => ...)` can be nested freely.
+	IgnoreArrowFunctions bool
+}
+
+const defaultMaxNestedFunctions = 3
+
+// functionNameNode returns the node that names the function `fn`,
+// or nil for anonymous functions that aren't assigned to a variable.
+func functionNameNode(fn *sitter.Node) *sitter.Node {
+	if name := fn.ChildByFieldName("name"); name != nil {
+		return name
+	}
+
+	// const f = () => ...
+	if parent := fn.Parent(); parent != nil && parent.Type() == "variable_declarator" {
+		return parent.ChildByFieldName("name")
+	}
+
+	return nil
+}
+
+func countsTowardsDepth(opts *MaxNestedFunctionsOptions, node *sitter.Node) bool {
+	return !(opts.IgnoreArrowFunctions && node.Type() == "arrow_function")
+}
+
+func enterFunction(opts *MaxNestedFunctionsOptions, depthKey string, ana *one.Analyzer, node *sitter.Node) {
+	// the file hook runs with the root node.
+	if node.Parent() == nil {
+		ana.Set(depthKey, 0)
+		return
+	}
+
+	if !countsTowardsDepth(opts, node) {
+		return
+	}
+
+	value, _ := ana.Get(depthKey)
+	depth, _ := value.(int)
+	depth++
+	ana.Set(depthKey, depth)
+
+	max := opts.Max
+	if max == 0 {
+		max = defaultMaxNestedFunctions
+	}
+
+	if depth <= max {
+		return
+	}
+
+	issue := &one.Issue{
+		Message: fmt.Sprintf("Function is nested %d levels deep. Maximum allowed is %d", depth, max),
+		Range:   node.Range(),
+		Node:    node,
+	}
+
+	if name := functionNameNode(node); name != nil {
+		issue.Message = fmt.Sprintf("'%s' is nested %d levels deep. Maximum allowed is %d",
+			name.Content(ana.ParseResult.Source), depth, max)
+		issue.Range = name.Range()
+	}
+
+	ana.Report(issue)
+}
+
+func leaveFunction(opts *MaxNestedFunctionsOptions, depthKey string, ana *one.Analyzer, node *sitter.Node) {
+	if node.Parent() == nil || !countsTowardsDepth(opts, node) {
+		return
+	}
+
+	value, _ := ana.Get(depthKey)
+	depth, _ := value.(int)
+	ana.Set(depthKey, depth-1)
+}
+
+// MaxNestedFunctions flags functions that are nested inside
+// more enclosing functions than the configured maximum.
+// When `opts` is nil, the default options are used.
+func MaxNestedFunctions(opts *MaxNestedFunctionsOptions) one.Rule {
+	if opts == nil {
+		opts = &MaxNestedFunctionsOptions{}
+	}
+
+	depthKey := instanceKey("js-max-nested-functions/depth")
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		enterFunction(opts, depthKey, ana, node)
+	}
+
+	var exit one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		leaveFunction(opts, depthKey, ana, node)
+	}
+
+	nodeTypes := append([]string{one.FileNodeType}, functionNodeTypes...)
+	return one.CreateMultiNodeRule("js-max-nested-functions", nodeTypes, one.LangJs, &entry, &exit)
+}
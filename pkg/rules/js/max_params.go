@@ -0,0 +1,65 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type MaxParamsOptions struct {
+	// Max is the maximum number of parameters a function can have.
+	// Defaults to 5 when 0.
+	Max int
+}
+
+const defaultMaxParams = 5
+
+// countParams returns the number of parameters in a parameter list.
+// Destructured and rest parameters count as one each,
+// and TypeScript's `this` parameter isn't counted.
+func countParams(params *sitter.Node) int {
+	count := 0
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		param := params.NamedChild(i)
+		if param.Type() == "comment" {
+			continue
+		}
+
+		if pattern := param.ChildByFieldName("pattern"); pattern != nil && pattern.Type() == "this" {
+			continue
+		}
+
+		count++
+	}
+
+	return count
+}
+
+func checkMaxParams(opts *MaxParamsOptions, ana *one.Analyzer, params *sitter.Node) {
+	max := opts.Max
+	if max == 0 {
+		max = defaultMaxParams
+	}
+
+	if count := countParams(params); count > max {
+		ana.Report(&one.Issue{
+			Message: fmt.Sprintf("Function has %d parameters, which exceeds the maximum of %d", count, max),
+			Range:   params.Range(),
+		})
+	}
+}
+
+// MaxParams flags functions that have too many parameters.
+// When `opts` is nil, the default options are used.
+func MaxParams(opts *MaxParamsOptions) one.Rule {
+	if opts == nil {
+		opts = &MaxParamsOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkMaxParams(opts, ana, node)
+	}
+
+	return one.CreateRule("js-max-params", "formal_parameters", one.LangJs, &entry, nil)
+}
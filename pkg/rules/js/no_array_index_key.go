@@ -0,0 +1,104 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// indexCallbackMethods are the array methods whose callbacks receive the index
+// of an element as their second argument, and are used to render lists.
+var indexCallbackMethods = []string{"map", "flatMap", "forEach"}
+
+// isArrayIndexParam returns true if `param` is the second parameter of a callback
+// passed to an array method like `.map()`, as in `xs.map((x, i) => ...)`.
+func isArrayIndexParam(param *sitter.Node, source []byte) bool {
+	params := param.Parent()
+	if params == nil || params.Type() != "formal_parameters" || params.NamedChildCount() < 2 || params.NamedChild(1) != param {
+		return false
+	}
+
+	fn := params.Parent()
+	args := fn.Parent()
+	if args == nil || args.Type() != "arguments" || args.NamedChild(0) != fn {
+		return false
+	}
+
+	callee := args.Parent().ChildByFieldName("function")
+	if callee == nil || callee.Type() != "member_expression" {
+		return false
+	}
+
+	property := callee.ChildByFieldName("property")
+	return property != nil && slices.Contains(indexCallbackMethods, property.Content(source))
+}
+
+// indexReferences returns the identifiers in `node` that refer to the index parameter of an array callback.
+// Using the index to look up an element (like `items[i].id`) is fine.
+func indexReferences(ana *one.Analyzer, node *sitter.Node, refs []*sitter.Node) []*sitter.Node {
+	switch node.Type() {
+	case "identifier":
+		parent := node.Parent()
+		if parent.Type() == "subscript_expression" && parent.ChildByFieldName("index") == node {
+			return refs
+		}
+
+		scope := ana.ParseResult.ScopeTree.GetScope(node)
+		if scope == nil {
+			return refs
+		}
+
+		variable := scope.Lookup(node.Content(ana.ParseResult.Source))
+		if variable != nil && variable.Kind == one.VarKindParameter && isArrayIndexParam(variable.DeclNode, ana.ParseResult.Source) {
+			refs = append(refs, node)
+		}
+
+		return refs
+
+	case "arrow_function", "function_expression":
+		// the index of a nested callback isn't the key.
+		return refs
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		refs = indexReferences(ana, node.NamedChild(i), refs)
+	}
+
+	return refs
+}
+
+func checkArrayIndexKey(ana *one.Analyzer, node *sitter.Node) {
+	if ana.ParseResult.ScopeTree == nil {
+		return
+	}
+
+	source := ana.ParseResult.Source
+	name, value := node.NamedChild(0), node.NamedChild(1)
+	if name == nil || value == nil || name.Content(source) != "key" || value.Type() != "jsx_expression" {
+		return
+	}
+
+	refs := indexReferences(ana, value, nil)
+	if len(refs) == 0 {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("Do not use the array index '%s' as a key. Use a stable id of the item instead", refs[0].Content(source)),
+		Range:   node.Range(),
+		Node:    node,
+	})
+}
+
+// NoArrayIndexKey flags JSX `key` props that use the index of an array callback,
+// like `items.map((item, i) => <li key={i} />)`.
+// Keys based on indices change when items are reordered, so React mixes up their state.
+func NoArrayIndexKey() one.Rule {
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkArrayIndexKey(ana, node)
+	}
+
+	return one.CreateRule("js-no-array-index-key", "jsx_attribute", one.LangJs, &entry, nil)
+}
@@ -0,0 +1,84 @@
+package js_rules
+
+import (
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoAwaitInLoopOptions struct {
+	// AllowWhileLoops allows `await` in `while` and `do ... while` loops,
+	// which are mostly used for intentionally serial work like polling and retries.
+	AllowWhileLoops bool
+}
+
+func isForAwait(loop *sitter.Node) bool {
+	return loop.Type() == "for_in_statement" && one.FirstChildOfType(loop, "await") != nil
+}
+
+// runsEveryIteration returns true if the `child` of `loop` is evaluated once per iteration.
+// The initializer of a `for` loop, and the iterable of a `for ... of` loop only run once.
+func runsEveryIteration(loop, child *sitter.Node) bool {
+	switch loop.Type() {
+	case "for_statement":
+		return loop.ChildByFieldName("initializer") != child
+	case "for_in_statement":
+		return loop.ChildByFieldName("right") != child && !isForAwait(loop)
+	default:
+		return true
+	}
+}
+
+// enclosingLoop returns the loop that `node` runs in every iteration of, or nil if there is none.
+// The search stops at function boundaries, since a function defined in a loop
+// isn't necessarily called in it.
+func enclosingLoop(opts *NoAwaitInLoopOptions, node *sitter.Node) *sitter.Node {
+	for child, parent := node, node.Parent(); parent != nil; child, parent = parent, parent.Parent() {
+		if slices.Contains(functionNodeTypes, parent.Type()) {
+			return nil
+		}
+
+		if !slices.Contains(loopNodeTypes, parent.Type()) {
+			continue
+		}
+
+		isWhile := parent.Type() == "while_statement" || parent.Type() == "do_statement"
+		if opts.AllowWhileLoops && isWhile {
+			continue
+		}
+
+		if runsEveryIteration(parent, child) {
+			return parent
+		}
+	}
+
+	return nil
+}
+
+func checkAwaitInLoop(opts *NoAwaitInLoopOptions, ana *one.Analyzer, node *sitter.Node) {
+	if enclosingLoop(opts, node) == nil {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: "Unexpected 'await' inside a loop. Consider running the promises concurrently with 'Promise.all'",
+		Range:   node.Range(),
+		Node:    node,
+	})
+}
+
+// NoAwaitInLoop flags `await` expressions inside loops, where each iteration waits
+// for the previous one to finish, even if they could run concurrently.
+// When `opts` is nil, the default options are used.
+func NoAwaitInLoop(opts *NoAwaitInLoopOptions) one.Rule {
+	if opts == nil {
+		opts = &NoAwaitInLoopOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkAwaitInLoop(opts, ana, node)
+	}
+
+	return one.CreateRule("js-no-await-in-loop", "await_expression", one.LangJs, &entry, nil)
+}
@@ -0,0 +1,64 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoBitwiseOptions struct {
+	// Allow is a list of bitwise operators that are allowed, like "|" or ">>>=".
+	Allow []string
+	// AllowIn is a list of patterns for files where all bitwise operators are allowed,
+	// like code that does bit manipulation on purpose.
+	AllowIn one.PathPatterns
+}
+
+var bitwiseOperators = []string{
+	"&", "|", "^", "~", "<<", ">>", ">>>",
+	"&=", "|=", "^=", "<<=", ">>=", ">>>=",
+}
+
+// mistakenLogicalOperators maps bitwise operators to the logical operators they are often mistaken for.
+var mistakenLogicalOperators = map[string]string{"&": "&&", "|": "||", "&=": "&&=", "|=": "||="}
+
+func checkNoBitwise(opts *NoBitwiseOptions, ana *one.Analyzer, node *sitter.Node) {
+	operator := node.ChildByFieldName("operator")
+	if operator == nil {
+		return
+	}
+
+	op := operator.Type()
+	if !slices.Contains(bitwiseOperators, op) || slices.Contains(opts.Allow, op) || ana.FileMatches(opts.AllowIn) {
+		return
+	}
+
+	message := fmt.Sprintf("Unexpected use of bitwise operator '%s'", op)
+	if logical, ok := mistakenLogicalOperators[op]; ok {
+		message += fmt.Sprintf(". Did you mean '%s'?", logical)
+	}
+
+	ana.Report(&one.Issue{
+		Message: message,
+		Range:   operator.Range(),
+		Node:    operator,
+	})
+}
+
+// NoBitwise flags bitwise operators, which are rare in most code
+// and are often typos for logical operators (`&` instead of `&&`).
+// When `opts` is nil, the default options are used.
+func NoBitwise(opts *NoBitwiseOptions) one.Rule {
+	if opts == nil {
+		opts = &NoBitwiseOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkNoBitwise(opts, ana, node)
+	}
+
+	nodeTypes := []string{"binary_expression", "unary_expression", "augmented_assignment_expression"}
+	return one.CreateMultiNodeRule("js-no-bitwise", nodeTypes, one.LangJs, &entry, nil)
+}
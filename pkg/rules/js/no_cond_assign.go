@@ -0,0 +1,74 @@
+package js_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoCondAssignOptions struct {
+	// AllowParenthesized allows assignments that are wrapped in an extra pair
+	// of parentheses, like `while ((match = re.exec(s)))`, to signal that they are intended.
+	// Enabled by default.
+	AllowParenthesized bool
+}
+
+// conditionOf returns the condition of an `if`, `while`, `do` or `for` statement,
+// without the parentheses that the syntax requires.
+func conditionOf(node *sitter.Node) *sitter.Node {
+	condition := node.ChildByFieldName("condition")
+	if condition == nil {
+		return nil
+	}
+
+	// `condition` is `(<expr>)` in `if (x)`, and `<expr>;` in `for (;x;)`
+	if condition.Type() == "parenthesized_expression" || condition.Type() == "expression_statement" {
+		if condition.NamedChildCount() != 1 {
+			return nil
+		}
+
+		return condition.NamedChild(0)
+	}
+
+	return condition
+}
+
+func checkCondAssign(opts *NoCondAssignOptions, ana *one.Analyzer, node *sitter.Node) {
+	condition := conditionOf(node)
+	if condition == nil {
+		return
+	}
+
+	if condition.Type() == "parenthesized_expression" {
+		if opts.AllowParenthesized {
+			return
+		}
+
+		condition = unwrapParens(condition)
+	}
+
+	if condition.Type() != "assignment_expression" && condition.Type() != "augmented_assignment_expression" {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: "Unexpected assignment in a condition. Did you mean to compare with '==='?",
+		Range:   condition.Range(),
+		Node:    condition,
+	})
+}
+
+// NoCondAssign flags assignments used as the condition of an `if`, `while`, `do` or `for`
+// statement, like `if (x = 5)`, which are usually a typo for a comparison.
+// When `opts` is nil, the default options are used.
+func NoCondAssign(opts *NoCondAssignOptions) one.Rule {
+	if opts == nil {
+		opts = &NoCondAssignOptions{AllowParenthesized: true}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkCondAssign(opts, ana, node)
+	}
+
+	nodeTypes := []string{"if_statement", "while_statement", "do_statement", "for_statement"}
+	return one.CreateMultiNodeRule("js-no-cond-assign", nodeTypes, one.LangJs, &entry, nil)
+}
@@ -0,0 +1,55 @@
+package js_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoConsoleOptions struct {
+	// AllowIn is a list of patterns for files where `console` calls are allowed.
+	AllowIn one.PathPatterns
+}
+
+var defaultNoConsoleOptions = NoConsoleOptions{
+	AllowIn: one.PathPatterns{
+		"**.test.*",
+		"**.spec.*",
+		"**/__tests__/**",
+	},
+}
+
+func checkNoConsole(opts *NoConsoleOptions, ana *one.Analyzer, node *sitter.Node) {
+	callee := node.ChildByFieldName("function")
+	if callee == nil || callee.Type() != "member_expression" {
+		return
+	}
+
+	object := callee.ChildByFieldName("object")
+	if object == nil || object.Type() != "identifier" || object.Content(ana.ParseResult.Source) != "console" {
+		return
+	}
+
+	if ana.FileMatches(opts.AllowIn) {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: "Unexpected call to 'console'. Remove it, or use a logger instead.",
+		Range:   node.Range(),
+	})
+}
+
+// NoConsole flags calls to `console.*` methods, except in files
+// that match the `AllowIn` patterns (test files, by default).
+// When `opts` is nil, the default options are used.
+func NoConsole(opts *NoConsoleOptions) one.Rule {
+	if opts == nil {
+		opts = &defaultNoConsoleOptions
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkNoConsole(opts, ana, node)
+	}
+
+	return one.CreateRule("js-no-console", "call_expression", one.LangJs, &entry, nil)
+}
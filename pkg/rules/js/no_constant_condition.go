@@ -0,0 +1,175 @@
+package js_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoConstantConditionOptions struct {
+	// AllowWhileTrue allows loops with a literal `true` condition,
+	// like `while (true) { ... }`.
+	AllowWhileTrue bool
+}
+
+type truthiness int
+
+const (
+	truthinessUnknown truthiness = iota
+	truthy
+	falsy
+)
+
+func operatorOf(node *sitter.Node) string {
+	if operator := node.ChildByFieldName("operator"); operator != nil {
+		return operator.Type()
+	}
+
+	return ""
+}
+
+// constantTruthiness returns whether the expression `node` is always truthy or always falsy,
+// or `truthinessUnknown` if that depends on the values of variables.
+func constantTruthiness(node *sitter.Node, source []byte) truthiness {
+	node = unwrapParens(node)
+	switch node.Type() {
+	case "true", "object", "array", "regex", "arrow_function", "function_expression", "function", "class":
+		return truthy
+	case "false", "null":
+		return falsy
+	case "number":
+		if value, ok := parseNumber(node.Content(source)); ok && value == 0 {
+			return falsy
+		}
+		return truthy
+	case "string":
+		if node.NamedChildCount() == 0 {
+			return falsy
+		}
+		return truthy
+	case "template_string":
+		if one.FirstChildOfType(node, "template_substitution") != nil {
+			return truthinessUnknown
+		}
+		if node.NamedChildCount() == 0 {
+			return falsy
+		}
+		return truthy
+	case "unary_expression":
+		argument := node.ChildByFieldName("argument")
+		switch operatorOf(node) {
+		case "void":
+			return falsy
+		case "typeof":
+			return truthy
+		case "!":
+			switch constantTruthiness(argument, source) {
+			case truthy:
+				return falsy
+			case falsy:
+				return truthy
+			}
+		}
+	case "binary_expression":
+		left := constantTruthiness(node.ChildByFieldName("left"), source)
+		right := constantTruthiness(node.ChildByFieldName("right"), source)
+		switch operatorOf(node) {
+		case "||":
+			if left == truthy || right == truthy {
+				return truthy
+			}
+			if left == falsy {
+				return right
+			}
+		case "&&":
+			if left == falsy || right == falsy {
+				return falsy
+			}
+			if left == truthy {
+				return right
+			}
+		}
+	}
+
+	return truthinessUnknown
+}
+
+// isConstant returns true if the value of the expression `node`
+// doesn't depend on any variables, e.g: `1 + 2`, `x || true`.
+func isConstant(node *sitter.Node, source []byte) bool {
+	node = unwrapParens(node)
+	if constantTruthiness(node, source) != truthinessUnknown {
+		return true
+	}
+
+	switch node.Type() {
+	case "unary_expression":
+		return operatorOf(node) != "delete" && isConstant(node.ChildByFieldName("argument"), source)
+	case "binary_expression":
+		operator := operatorOf(node)
+		if operator == "||" || operator == "&&" || operator == "??" || operator == "in" || operator == "instanceof" {
+			return false
+		}
+		return isConstant(node.ChildByFieldName("left"), source) && isConstant(node.ChildByFieldName("right"), source)
+	case "assignment_expression":
+		// if (x = 1) { ... }
+		return isConstant(node.ChildByFieldName("right"), source)
+	case "sequence_expression":
+		last := node.NamedChild(int(node.NamedChildCount()) - 1)
+		return last != nil && isConstant(last, source)
+	}
+
+	return false
+}
+
+func checkConstantCondition(opts *NoConstantConditionOptions, ana *one.Analyzer, node *sitter.Node) {
+	condition := node.ChildByFieldName("condition")
+	if condition == nil {
+		return
+	}
+
+	// for (<init>; <condition>; <update>) { ... }
+	expr := condition
+	if expr.Type() == "expression_statement" {
+		expr = expr.NamedChild(0)
+	}
+
+	if expr == nil || expr.Type() == "empty_statement" {
+		return
+	}
+
+	isLoop := node.Type() != "if_statement" && node.Type() != "ternary_expression"
+	if isLoop && opts.AllowWhileTrue && unwrapParens(expr).Type() == "true" {
+		return
+	}
+
+	if !isConstant(expr, ana.ParseResult.Source) {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: "Unexpected constant condition",
+		Range:   condition.Range(),
+		Node:    condition,
+	})
+}
+
+// NoConstantCondition flags conditions whose value is known without running
+// the program, like `if (true)` or `while (x || 1)`. These are usually bugs.
+// When `opts` is nil, `while (true)` loops are allowed.
+func NoConstantCondition(opts *NoConstantConditionOptions) one.Rule {
+	if opts == nil {
+		opts = &NoConstantConditionOptions{AllowWhileTrue: true}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkConstantCondition(opts, ana, node)
+	}
+
+	return one.CreateMultiNodeRule(
+		"js-no-constant-condition",
+		[]string{"if_statement", "while_statement", "do_statement", "for_statement", "ternary_expression"},
+		one.LangJs,
+		&entry,
+		nil,
+	)
+}
@@ -0,0 +1,225 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// access is a read or a write of a variable,
+// at the position where it takes effect.
+type access struct {
+	pos     uint32
+	isWrite bool
+	// node is the assignment, update expression, or declarator for writes,
+	// and the identifier for reads.
+	node *sitter.Node
+}
+
+var loopNodeTypes = []string{"for_statement", "for_in_statement", "while_statement", "do_statement"}
+
+var jumpNodeTypes = []string{"break_statement", "continue_statement", "throw_statement"}
+
+func nearestFunction(node *sitter.Node) *sitter.Node {
+	for n := node.Parent(); n != nil; n = n.Parent() {
+		if slices.Contains(functionNodeTypes, n.Type()) {
+			return n
+		}
+	}
+
+	return nil
+}
+
+// accessesOf returns all reads and writes of `variable` in evaluation order.
+// For `x = x + 1`, the read of `x` on the right comes before the write.
+func accessesOf(variable *one.Variable) []access {
+	var accesses []access
+
+	decl := variable.DeclNode
+	if decl.Type() == "variable_declarator" && decl.ChildByFieldName("value") != nil {
+		if name := decl.ChildByFieldName("name"); name != nil && name.Type() == "identifier" {
+			accesses = append(accesses, access{pos: decl.EndByte(), isWrite: true, node: decl})
+		}
+	}
+
+	for _, ref := range variable.Refs {
+		if !ref.IsWriteRef {
+			accesses = append(accesses, access{pos: ref.Node.StartByte(), node: ref.Node})
+			continue
+		}
+
+		write := ref.Node.Parent()
+		switch write.Type() {
+		case "augmented_assignment_expression", "update_expression":
+			// `x += 1` and `x++` read the old value first.
+			accesses = append(accesses, access{pos: write.StartByte(), node: ref.Node})
+		case "for_in_statement":
+			write = ref.Node
+		}
+
+		accesses = append(accesses, access{pos: write.EndByte(), isWrite: true, node: write})
+	}
+
+	sort.SliceStable(accesses, func(i, j int) bool {
+		return accesses[i].pos < accesses[j].pos
+	})
+
+	return accesses
+}
+
+// isLocalTo returns true if all accesses to `variable` happen inside `fn`,
+// and not in a nested function that could run at any time.
+func isLocalTo(variable *one.Variable, fn *sitter.Node) bool {
+	for _, ref := range variable.Refs {
+		if nearestFunction(ref.Node) != fn {
+			return false
+		}
+	}
+
+	return true
+}
+
+// enclosingContext reports whether `node` is inside a loop,
+// or the body of a try statement, in the function `fn`.
+func enclosingContext(node, fn *sitter.Node) (inLoop bool, inTry bool) {
+	for child, parent := node, node.Parent(); parent != nil && parent != fn; child, parent = parent, parent.Parent() {
+		if slices.Contains(loopNodeTypes, parent.Type()) {
+			inLoop = true
+		}
+
+		if parent.Type() == "try_statement" && parent.ChildByFieldName("body") == child {
+			inTry = true
+		}
+	}
+
+	return inLoop, inTry
+}
+
+// hasJump returns true if `node` contains a break, continue, or throw
+// that starts within the byte range [from, to).
+func hasJump(node *sitter.Node, from, to uint32) bool {
+	if node.EndByte() <= from || node.StartByte() >= to || slices.Contains(functionNodeTypes, node.Type()) {
+		return false
+	}
+
+	if slices.Contains(jumpNodeTypes, node.Type()) && node.StartByte() >= from {
+		return true
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if hasJump(node.NamedChild(i), from, to) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// overwrites returns true if `next` always runs after `write`,
+// without anything else happening in between that could skip it.
+// This is only the case when `next` is a statement of its own, in a
+// block that `write` is also a part of.
+func overwrites(next, write *sitter.Node) bool {
+	if next.Type() != "assignment_expression" {
+		return false
+	}
+
+	stmt := next.Parent()
+	if stmt == nil || stmt.Type() != "expression_statement" {
+		return false
+	}
+
+	block := stmt.Parent()
+	writeStmt := write
+	for writeStmt != nil && writeStmt.Parent() != block {
+		if slices.Contains(functionNodeTypes, writeStmt.Type()) {
+			return false
+		}
+
+		writeStmt = writeStmt.Parent()
+	}
+
+	if writeStmt == nil || writeStmt == stmt {
+		return false
+	}
+
+	return !hasJump(block, write.EndByte(), stmt.StartByte())
+}
+
+func deadStores(variable *one.Variable, fn *sitter.Node) []*sitter.Node {
+	var dead []*sitter.Node
+	accesses := accessesOf(variable)
+	for i, acc := range accesses {
+		if !acc.isWrite {
+			continue
+		}
+
+		inLoop, inTry := enclosingContext(acc.node, fn)
+		if i == len(accesses)-1 {
+			// the next iteration of a loop may still read the value.
+			if !inLoop {
+				dead = append(dead, acc.node)
+			}
+
+			continue
+		}
+
+		// a catch block may read the value if the code in between throws.
+		next := accesses[i+1]
+		if next.isWrite && !inTry && overwrites(next.node, acc.node) {
+			dead = append(dead, acc.node)
+		}
+	}
+
+	return dead
+}
+
+func checkDeadStores(ana *one.Analyzer, scope *one.Scope) {
+	var issues []*one.Issue
+	for name, variable := range scope.Variables {
+		if variable.Kind != one.VarKindVariable && variable.Kind != one.VarKindParameter {
+			continue
+		}
+
+		fn := nearestFunction(variable.DeclNode)
+		if fn == nil || !isLocalTo(variable, fn) {
+			continue
+		}
+
+		for _, store := range deadStores(variable, fn) {
+			issues = append(issues, &one.Issue{
+				Message: fmt.Sprintf("Value assigned to '%s' is never read", name),
+				Range:   store.Range(),
+			})
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].Range.StartByte < issues[j].Range.StartByte
+	})
+
+	for _, issue := range issues {
+		ana.Report(issue)
+	}
+
+	for _, child := range scope.Children {
+		checkDeadStores(ana, child)
+	}
+}
+
+// NoDeadStore flags assignments to local variables whose value is
+// never read before being overwritten, or before the function returns.
+// The analysis is local to straight-line code inside a function: variables
+// that are captured by nested functions are not checked.
+func NoDeadStore() one.Rule {
+	var exit one.VisitFn = func(r one.Rule, ana *one.Analyzer, root *sitter.Node) {
+		if scopeTree := ana.ParseResult.ScopeTree; scopeTree != nil {
+			checkDeadStores(ana, scopeTree.Root)
+		}
+	}
+
+	return one.CreateRule("js-no-dead-store", one.FileNodeType, one.LangJs, nil, &exit)
+}
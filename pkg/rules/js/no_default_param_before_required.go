@@ -0,0 +1,61 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+func checkDefaultParamBeforeRequired(ana *one.Analyzer, node *sitter.Node) {
+	source := ana.ParseResult.Source
+
+	var defaulted *sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		param := node.NamedChild(i)
+		// optional parameters (`x?: T`) can be omitted just like defaulted ones.
+		if param.Type() != "required_parameter" {
+			continue
+		}
+
+		pattern := param.ChildByFieldName("pattern")
+		if pattern == nil || pattern.Type() == "rest_pattern" {
+			continue
+		}
+
+		if param.ChildByFieldName("value") != nil {
+			if defaulted == nil {
+				defaulted = param
+			}
+			continue
+		}
+
+		if defaulted == nil {
+			continue
+		}
+
+		defaultedPattern := defaulted.ChildByFieldName("pattern")
+		ana.Report(&one.Issue{
+			Message: fmt.Sprintf(
+				"Required parameter '%s' comes after '%s', which has a default value",
+				pattern.Content(source),
+				defaultedPattern.Content(source),
+			),
+			Range: param.Range(),
+			Node:  param,
+			Related: []one.RelatedLocation{
+				{Message: "Parameter with a default value", Range: defaulted.Range()},
+			},
+		})
+	}
+}
+
+// NoDefaultParamBeforeRequired flags required parameters that come after a parameter with a default value,
+// like `function f(a = 1, b) {}`. The default can only be used by passing `undefined` explicitly.
+func NoDefaultParamBeforeRequired() one.Rule {
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkDefaultParamBeforeRequired(ana, node)
+	}
+
+	return one.CreateRule("js-no-default-param-before-required", "formal_parameters", one.LangJs, &entry, nil)
+}
@@ -15,7 +15,9 @@ func noDoubleEq(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
 	}
 }
 
+// NoDoubleEq flags comparisons with `==`.
+// See RequireStrictEquality for a stricter rule that also flags `!=`.
 func NoDoubleEq() one.Rule {
 	var entry one.VisitFn = noDoubleEq
-	return one.CreateRule("binary_expression", one.LangJs, &entry, nil)
+	return one.CreateRule("js-no-double-eq", "binary_expression", one.LangJs, &entry, nil)
 }
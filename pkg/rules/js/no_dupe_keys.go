@@ -0,0 +1,115 @@
+package js_rules
+
+import (
+	"fmt"
+	"strconv"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// literalKeyName returns the name of a property key that is known statically.
+// `ok` is false for computed keys that are not literals, like `[foo]`.
+func literalKeyName(key *sitter.Node, source []byte) (name string, ok bool) {
+	switch key.Type() {
+	case "property_identifier", "shorthand_property_identifier", "private_property_identifier":
+		return key.Content(source), true
+
+	case "string":
+		text := key.Content(source)
+		return text[1 : len(text)-1], true
+
+	case "template_string":
+		// `a` is a literal, but `${a}` is not
+		if one.FirstChildOfType(key, "template_substitution") != nil {
+			return "", false
+		}
+		text := key.Content(source)
+		return text[1 : len(text)-1], true
+
+	case "number":
+		// `1`, `1.0`, and `0x1` are all the same key
+		value, ok := parseNumber(key.Content(source))
+		if !ok {
+			return "", false
+		}
+		return strconv.FormatFloat(value, 'g', -1, 64), true
+
+	case "computed_property_name":
+		if key.NamedChildCount() != 1 {
+			return "", false
+		}
+		return literalKeyName(key.NamedChild(0), source)
+
+	default:
+		return "", false
+	}
+}
+
+func isAccessor(method *sitter.Node) bool {
+	for i := 0; i < int(method.ChildCount()); i++ {
+		typ := method.Child(i).Type()
+		if typ == "get" || typ == "set" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// keyOfProperty returns the key node of an object literal member,
+// or nil if the member doesn't have a key that can be checked.
+func keyOfProperty(property *sitter.Node) *sitter.Node {
+	switch property.Type() {
+	case "pair":
+		return property.ChildByFieldName("key")
+	case "shorthand_property_identifier":
+		return property
+	case "method_definition":
+		// a getter and a setter can share a name
+		if isAccessor(property) {
+			return nil
+		}
+		return property.ChildByFieldName("name")
+	default:
+		return nil
+	}
+}
+
+func checkDupeKeys(r one.Rule, ana *one.Analyzer, object *sitter.Node) {
+	source := ana.ParseResult.Source
+	firstKeyWithName := map[string]*sitter.Node{}
+
+	for i := 0; i < int(object.NamedChildCount()); i++ {
+		key := keyOfProperty(object.NamedChild(i))
+		if key == nil {
+			continue
+		}
+
+		name, ok := literalKeyName(key, source)
+		if !ok {
+			continue
+		}
+
+		first, exists := firstKeyWithName[name]
+		if !exists {
+			firstKeyWithName[name] = key
+			continue
+		}
+
+		ana.Report(&one.Issue{
+			Message: fmt.Sprintf("Duplicate key '%s' overwrites an earlier property", name),
+			Range:   key.Range(),
+			Related: []one.RelatedLocation{{
+				Message: fmt.Sprintf("'%s' is first defined here", name),
+				Range:   first.Range(),
+			}},
+		})
+	}
+}
+
+// NoDupeKeys flags object literals that define the same key more than once.
+func NoDupeKeys() one.Rule {
+	var entry one.VisitFn = checkDupeKeys
+	return one.CreateRule("js-no-dupe-keys", "object", one.LangJs, &entry, nil)
+}
@@ -0,0 +1,217 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// importKey groups imports of the same module.
+// Type-only imports (`import type ...`) are a separate group.
+type importKey struct {
+	module   string
+	typeOnly bool
+}
+
+// importBindings are the names bound by an import statement.
+type importBindings struct {
+	defaultImport string
+	named         []string
+	// namespace is set for `import * as ns`, which can't be merged with named imports.
+	namespace bool
+}
+
+func bindingsOf(importStmt *sitter.Node, source []byte) importBindings {
+	var bindings importBindings
+	clause := one.FirstChildOfType(importStmt, "import_clause")
+	if clause == nil {
+		// import "module"
+		return bindings
+	}
+
+	if defaultImport := one.FirstChildOfType(clause, "identifier"); defaultImport != nil {
+		bindings.defaultImport = defaultImport.Content(source)
+	}
+
+	bindings.namespace = one.FirstChildOfType(clause, "namespace_import") != nil
+	if named := one.FirstChildOfType(clause, "named_imports"); named != nil {
+		for _, specifier := range one.ChildrenOfType(named, "import_specifier") {
+			bindings.named = append(bindings.named, specifier.Content(source))
+		}
+	}
+
+	return bindings
+}
+
+// mergedImport returns a single import statement that binds all the names
+// imported by `imports`, or false if they can't be combined.
+func mergedImport(imports []*sitter.Node, source []byte) (string, bool) {
+	var merged importBindings
+	for _, importStmt := range imports {
+		bindings := bindingsOf(importStmt, source)
+		if bindings.namespace {
+			return "", false
+		}
+
+		if bindings.defaultImport != "" {
+			if merged.defaultImport != "" && merged.defaultImport != bindings.defaultImport {
+				return "", false
+			}
+
+			merged.defaultImport = bindings.defaultImport
+		}
+
+		for _, name := range bindings.named {
+			if !slices.Contains(merged.named, name) {
+				merged.named = append(merged.named, name)
+			}
+		}
+	}
+
+	first := imports[0]
+	var clause []string
+	if merged.defaultImport != "" {
+		clause = append(clause, merged.defaultImport)
+	}
+
+	if len(merged.named) > 0 {
+		clause = append(clause, "{ "+strings.Join(merged.named, ", ")+" }")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("import ")
+	if isTypeOnlyImport(first) {
+		sb.WriteString("type ")
+	}
+
+	if len(clause) > 0 {
+		sb.WriteString(strings.Join(clause, ", "))
+		sb.WriteString(" from ")
+	}
+
+	sb.WriteString(first.ChildByFieldName("source").Content(source))
+	if strings.HasSuffix(first.Content(source), ";") {
+		sb.WriteString(";")
+	}
+
+	return sb.String(), true
+}
+
+// removalRange returns the byte range to delete to remove `stmt`, which includes
+// its indentation and line break when it's the only thing on its line.
+func removalRange(stmt *sitter.Node, source []byte) (start, end uint32) {
+	start, end = stmt.StartByte(), stmt.EndByte()
+	lineStart := start
+	for lineStart > 0 && (source[lineStart-1] == ' ' || source[lineStart-1] == '\t') {
+		lineStart--
+	}
+
+	if lineStart > 0 && source[lineStart-1] != '\n' {
+		return start, end
+	}
+
+	switch {
+	case int(end) < len(source) && source[end] == '\n':
+		start, end = lineStart, end+1
+	case int(end) == len(source) && lineStart > 0:
+		// the last line of the file, so remove the line break before it.
+		start = lineStart - 1
+	}
+
+	return start, end
+}
+
+// mergeImportsFix rewrites the first import in `imports` to bind all the imported names,
+// and removes the others.
+func mergeImportsFix(imports []*sitter.Node, source []byte) *one.Fix {
+	merged, ok := mergedImport(imports, source)
+	if !ok {
+		return nil
+	}
+
+	first := imports[0]
+	var sb strings.Builder
+	sb.WriteString(merged)
+
+	offset := first.EndByte()
+	for _, duplicate := range imports[1:] {
+		start, end := removalRange(duplicate, source)
+		sb.Write(source[offset:start])
+		offset = end
+	}
+
+	last := imports[len(imports)-1]
+	endPoint := last.EndPoint()
+	if offset > last.EndByte() {
+		// the line break after the last import was removed too.
+		endPoint = sitter.Point{Row: endPoint.Row + 1}
+	}
+
+	return &one.Fix{
+		Range: sitter.Range{
+			StartPoint: first.StartPoint(),
+			EndPoint:   endPoint,
+			StartByte:  first.StartByte(),
+			EndByte:    offset,
+		},
+		Replacement: sb.String(),
+	}
+}
+
+func checkDuplicateImports(ana *one.Analyzer, root *sitter.Node) {
+	source := ana.ParseResult.Source
+
+	var keys []importKey
+	groups := map[importKey][]*sitter.Node{}
+	for _, importStmt := range one.ChildrenOfType(root, "import_statement") {
+		if importStmt.ChildByFieldName("source") == nil {
+			continue
+		}
+
+		key := importKey{importSource(importStmt, source), isTypeOnlyImport(importStmt)}
+		if groups[key] == nil {
+			keys = append(keys, key)
+		}
+
+		groups[key] = append(groups[key], importStmt)
+	}
+
+	for _, key := range keys {
+		imports := groups[key]
+		if len(imports) < 2 {
+			continue
+		}
+
+		first := imports[0]
+		for i, duplicate := range imports[1:] {
+			issue := &one.Issue{
+				Message: fmt.Sprintf("'%s' is already imported. Merge the imports into a single statement", key.module),
+				Range:   duplicate.Range(),
+				Node:    duplicate,
+				Related: []one.RelatedLocation{{
+					Message: fmt.Sprintf("'%s' is first imported here", key.module),
+					Range:   first.Range(),
+				}},
+			}
+
+			// one fix merges all imports of the module.
+			if i == 0 {
+				issue.Fix = mergeImportsFix(imports, source)
+			}
+
+			ana.Report(issue)
+		}
+	}
+}
+
+// NoDuplicateImports flags modules that are imported by more than one import statement.
+func NoDuplicateImports() one.Rule {
+	var exit one.VisitFn = func(r one.Rule, ana *one.Analyzer, root *sitter.Node) {
+		checkDuplicateImports(ana, root)
+	}
+
+	return one.CreateRule("js-no-duplicate-imports", one.FileNodeType, one.LangJs, nil, &exit)
+}
@@ -0,0 +1,135 @@
+package js_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// lastStatement returns the last statement in a block (ignoring comments),
+// or the statement itself if it isn't a block.
+func lastStatement(stmt *sitter.Node) *sitter.Node {
+	if stmt.Type() != "statement_block" {
+		return stmt
+	}
+
+	for i := int(stmt.NamedChildCount()) - 1; i >= 0; i-- {
+		child := stmt.NamedChild(i)
+		if child.Type() != "comment" {
+			return child
+		}
+	}
+
+	return nil
+}
+
+func alwaysReturns(stmt *sitter.Node) bool {
+	last := lastStatement(stmt)
+	return last != nil && (last.Type() == "return_statement" || last.Type() == "throw_statement")
+}
+
+// statementsOf returns the statements inside a block,
+// or the statement itself if it isn't a block.
+func statementsOf(stmt *sitter.Node) []*sitter.Node {
+	if stmt.Type() != "statement_block" {
+		return []*sitter.Node{stmt}
+	}
+
+	var stmts []*sitter.Node
+	for i := 0; i < int(stmt.NamedChildCount()); i++ {
+		stmts = append(stmts, stmt.NamedChild(i))
+	}
+
+	return stmts
+}
+
+// unwrapElseFix returns a fix that replaces the `else` clause with its body,
+// or nil if doing so could change the meaning of the program.
+func unwrapElseFix(ifStmt, elseClause, elseBody *sitter.Node, source []byte) *one.Fix {
+	// `while (x) if (y) return; else z()` can't be flattened
+	parent := ifStmt.Parent()
+	if parent == nil {
+		return nil
+	}
+
+	switch parent.Type() {
+	case "program", "statement_block", "switch_case", "switch_default":
+	default:
+		return nil
+	}
+
+	// a comment between `else` and its body would be deleted along with the `else`.
+	if one.FirstChildOfType(elseClause, "comment") != nil {
+		return nil
+	}
+
+	stmts := statementsOf(elseBody)
+	if len(stmts) == 0 {
+		return nil
+	}
+
+	// moving block-scoped declarations out of the block may cause name clashes
+	for _, stmt := range stmts {
+		switch stmt.Type() {
+		case "lexical_declaration", "class_declaration", "function_declaration":
+			return nil
+		}
+	}
+
+	// this is the comment before `else` if there is one, which is kept.
+	consequence := elseClause.PrevSibling()
+	indent := indentationOf(ifStmt, source)
+	return &one.Fix{
+		Range: sitter.Range{
+			StartPoint: consequence.EndPoint(),
+			EndPoint:   elseClause.EndPoint(),
+			StartByte:  consequence.EndByte(),
+			EndByte:    elseClause.EndByte(),
+		},
+		Replacement: "\n" + reindent(stmts[0], stmts[len(stmts)-1], indent, source),
+	}
+}
+
+func checkElseReturn(r one.Rule, ana *one.Analyzer, ifStmt *sitter.Node) {
+	// only the first `if` in an `if-else-if` chain is checked
+	if parent := ifStmt.Parent(); parent != nil && parent.Type() == "else_clause" {
+		return
+	}
+
+	var elseClause *sitter.Node
+	for stmt := ifStmt; ; {
+		consequence := stmt.ChildByFieldName("consequence")
+		if consequence == nil || !alwaysReturns(consequence) {
+			return
+		}
+
+		elseClause = stmt.ChildByFieldName("alternative")
+		if elseClause == nil {
+			return
+		}
+
+		body := one.FindMatchingChild(elseClause, func(child *sitter.Node) bool {
+			return child.IsNamed() && child.Type() != "comment"
+		})
+		if body == nil {
+			return
+		}
+
+		if body.Type() != "if_statement" {
+			ana.Report(&one.Issue{
+				Message: "Unnecessary 'else' after 'return'",
+				Range:   elseClause.Range(),
+				Fix:     unwrapElseFix(ifStmt, elseClause, body, ana.ParseResult.Source),
+			})
+			return
+		}
+
+		stmt = body
+	}
+}
+
+// NoElseReturn flags `else` blocks that follow an `if` block (or a chain of them)
+// that always returns or throws, since the `else` is redundant.
+func NoElseReturn() one.Rule {
+	var entry one.VisitFn = checkElseReturn
+	return one.CreateRule("js-no-else-return", "if_statement", one.LangJs, &entry, nil)
+}
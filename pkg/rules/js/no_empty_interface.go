@@ -0,0 +1,91 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoEmptyInterfaceOptions struct {
+	// AllowExtends allows empty interfaces that extend another interface,
+	// like `interface Props extends BaseProps {}`.
+	AllowExtends bool
+}
+
+// hasMembers returns true if an interface body has anything other than comments.
+func hasMembers(body *sitter.Node) bool {
+	for i := 0; i < int(body.NamedChildCount()); i++ {
+		if body.NamedChild(i).Type() != "comment" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// extendedTypes returns the types in the `extends` clause of an interface declaration.
+func extendedTypes(node *sitter.Node) []*sitter.Node {
+	var types []*sitter.Node
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		clause := node.NamedChild(i)
+		if clause.Type() != "extends_type_clause" {
+			continue
+		}
+
+		for j := 0; j < int(clause.NamedChildCount()); j++ {
+			types = append(types, clause.NamedChild(j))
+		}
+	}
+
+	return types
+}
+
+func checkEmptyInterface(opts *NoEmptyInterfaceOptions, ana *one.Analyzer, node *sitter.Node) {
+	name := node.ChildByFieldName("name")
+	body := node.ChildByFieldName("body")
+	if name == nil || body == nil || hasMembers(body) {
+		return
+	}
+
+	source := ana.ParseResult.Source
+	var message string
+	switch supertypes := extendedTypes(node); len(supertypes) {
+	case 0:
+		message = fmt.Sprintf("Interface '%s' is empty, and is equivalent to '{}'", name.Content(source))
+	case 1:
+		if opts.AllowExtends {
+			return
+		}
+
+		message = fmt.Sprintf(
+			"Interface '%s' is empty, and is equivalent to '%s'. Use a type alias instead",
+			name.Content(source),
+			supertypes[0].Content(source),
+		)
+	default:
+		// `interface C extends A, B {}` is a common way to combine types.
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: message,
+		Range:   name.Range(),
+		Node:    name,
+	})
+}
+
+// NoEmptyInterface flags TypeScript interfaces with no members,
+// which are either a mistake or better written as a type alias.
+// When `opts` is nil, the default options are used.
+func NoEmptyInterface(opts *NoEmptyInterfaceOptions) one.Rule {
+	if opts == nil {
+		opts = &NoEmptyInterfaceOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkEmptyInterface(opts, ana, node)
+	}
+
+	return one.CreateRule("ts-no-empty-interface", "interface_declaration", one.LangTs, &entry, nil)
+}
@@ -0,0 +1,194 @@
+package js_rules
+
+import (
+	"slices"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoExtraParensOptions struct {
+	// IncludeExpressions also flags parentheses around entire expressions
+	// in return statements and arrow function bodies, like `return (a + b)`,
+	// which are often kept for readability.
+	// By default, only parentheses that are redundant in any context, or that wrap
+	// an entire initializer or assigned value (like `const x = (a * b)`), are flagged.
+	IncludeExpressions bool
+}
+
+// atomicExpressionTypes are expressions that never need parentheses around them.
+var atomicExpressionTypes = []string{
+	"identifier",
+	"number",
+	"string",
+	"template_string",
+	"regex",
+	"true",
+	"false",
+	"null",
+	"undefined",
+	"this",
+	"parenthesized_expression",
+}
+
+// parenthesizedSyntaxTypes are statements whose syntax requires
+// parentheses, like the condition in `if (x)`.
+var parenthesizedSyntaxTypes = []string{
+	"if_statement",
+	"while_statement",
+	"do_statement",
+	"switch_statement",
+	"with_statement",
+}
+
+// isWordByte returns true if `b` can be part of an identifier or keyword.
+func isWordByte(b byte) bool {
+	return b == '_' || b == '$' || b >= 0x80 ||
+		('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z') || ('0' <= b && b <= '9')
+}
+
+// isAtomicParens returns true if `inner` would mean the same thing without the parentheses
+// around it, wherever it appears.
+func isAtomicParens(node, inner *sitter.Node) bool {
+	if !slices.Contains(atomicExpressionTypes, inner.Type()) {
+		return false
+	}
+
+	parent := node.Parent()
+	switch {
+	case inner.Type() == "number" && parent.Type() == "member_expression":
+		// `(1).toString()`
+		return false
+	case inner.Type() == "string" && parent.Type() == "expression_statement":
+		// without parentheses, this could be a directive like "use strict".
+		return false
+	}
+
+	return true
+}
+
+// isStatementParens returns true if `node` wraps an entire expression statement, like `(foo());`.
+func isStatementParens(node, inner *sitter.Node, source []byte) bool {
+	if node.Parent().Type() != "expression_statement" {
+		return false
+	}
+
+	// `({ a } = b)`, `(function () {})` and `(class {})` would be parsed as
+	// blocks and declarations without the parentheses.
+	text := inner.Content(source)
+	if strings.HasPrefix(text, "{") {
+		return false
+	}
+
+	for _, keyword := range []string{"function", "async", "class", "let"} {
+		if strings.HasPrefix(text, keyword) && (len(text) == len(keyword) || !isWordByte(text[len(keyword)])) {
+			return false
+		}
+	}
+
+	return !slices.Contains([]string{"string", "sequence_expression"}, inner.Type())
+}
+
+// isValueParens returns true if `node` wraps an entire expression that
+// initializes a variable or is assigned, like `const x = (a * b)`.
+func isValueParens(node, inner *sitter.Node) bool {
+	if inner.Type() == "sequence_expression" || strings.HasPrefix(inner.Type(), "jsx_") {
+		return false
+	}
+
+	parent := node.Parent()
+	switch parent.Type() {
+	case "variable_declarator":
+		return parent.ChildByFieldName("value") == node
+	case "assignment_expression", "augmented_assignment_expression":
+		return parent.ChildByFieldName("right") == node
+	default:
+		return false
+	}
+}
+
+// isExpressionParens returns true if `node` wraps an entire expression that is
+// returned, or used as the body of an arrow function.
+func isExpressionParens(node, inner *sitter.Node) bool {
+	if inner.Type() == "sequence_expression" || strings.HasPrefix(inner.Type(), "jsx_") {
+		return false
+	}
+
+	parent := node.Parent()
+	switch parent.Type() {
+	case "return_statement":
+		return true
+	case "arrow_function":
+		return inner.Type() != "object" && parent.ChildByFieldName("body") == node
+	default:
+		return false
+	}
+}
+
+// withoutParens returns the text that replaces `node` when its parentheses are removed.
+// A space is kept where removing the parentheses would join two words, like `typeof(x)`.
+func withoutParens(node, inner *sitter.Node, source []byte) string {
+	text := inner.Content(source)
+	start, end := node.StartByte(), node.EndByte()
+	if start > 0 && isWordByte(source[start-1]) && isWordByte(text[0]) {
+		text = " " + text
+	}
+
+	if int(end) < len(source) && isWordByte(source[end]) && isWordByte(text[len(text)-1]) {
+		text += " "
+	}
+
+	return text
+}
+
+func checkExtraParens(opts *NoExtraParensOptions, ana *one.Analyzer, node *sitter.Node) {
+	// a comment inside the parentheses would be lost when removing them.
+	if node.ChildCount() != 3 || node.NamedChildCount() != 1 {
+		return
+	}
+
+	parent := node.Parent()
+	if parent == nil || slices.Contains(parenthesizedSyntaxTypes, parent.Type()) {
+		return
+	}
+
+	inner := node.NamedChild(0)
+	// `return (\n x)` would return `undefined` without the parentheses.
+	if inner.StartPoint().Row != node.StartPoint().Row {
+		return
+	}
+
+	source := ana.ParseResult.Source
+	if !isAtomicParens(node, inner) &&
+		!isStatementParens(node, inner, source) &&
+		!isValueParens(node, inner) &&
+		!(opts.IncludeExpressions && isExpressionParens(node, inner)) {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: "Unnecessary parentheses around expression",
+		Range:   node.Range(),
+		Node:    node,
+		Fix: &one.Fix{
+			Range:       node.Range(),
+			Replacement: withoutParens(node, inner, source),
+		},
+	})
+}
+
+// NoExtraParens flags parentheses that don't change how an expression is evaluated,
+// like `return (x)`, `((a + b))`, `const a = (b + c)`, or `(foo());`.
+// When `opts` is nil, the default options are used.
+func NoExtraParens(opts *NoExtraParensOptions) one.Rule {
+	if opts == nil {
+		opts = &NoExtraParensOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkExtraParens(opts, ana, node)
+	}
+
+	return one.CreateRule("js-no-extra-parens", "parenthesized_expression", one.LangJs, &entry, nil)
+}
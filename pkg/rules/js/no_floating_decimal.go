@@ -0,0 +1,63 @@
+package js_rules
+
+import (
+	"fmt"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// normalizedDecimal returns `number` with a zero added before a leading dot (`.5` -> `0.5`),
+// or after a trailing dot (`2.` -> `2.0`, `2.e5` -> `2.0e5`).
+// It returns false if the literal has no such dot.
+func normalizedDecimal(number string) (string, bool) {
+	lower := strings.ToLower(number)
+	// hexadecimal, binary and octal literals can't have a fraction,
+	// and `e` is a digit in hexadecimal.
+	if strings.HasPrefix(lower, "0x") || strings.HasPrefix(lower, "0b") || strings.HasPrefix(lower, "0o") {
+		return "", false
+	}
+
+	dot := strings.IndexByte(number, '.')
+	switch {
+	case dot == -1:
+		return "", false
+	case dot == 0:
+		return "0" + number, true
+	case dot == len(number)-1 || lower[dot+1] == 'e':
+		return number[:dot+1] + "0" + number[dot+1:], true
+	default:
+		return "", false
+	}
+}
+
+func checkFloatingDecimal(ana *one.Analyzer, node *sitter.Node) {
+	text := node.Content(ana.ParseResult.Source)
+	fixed, ok := normalizedDecimal(text)
+	if !ok {
+		return
+	}
+
+	position := "leading"
+	if !strings.HasPrefix(text, ".") {
+		position = "trailing"
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("Number '%s' has a %s decimal point. Use '%s' instead", text, position, fixed),
+		Range:   node.Range(),
+		Node:    node,
+		Fix:     &one.Fix{Range: node.Range(), Replacement: fixed},
+	})
+}
+
+// NoFloatingDecimal flags numbers with a decimal point but no digits on one side
+// of it, like `.5` and `2.`, which are easy to misread.
+func NoFloatingDecimal() one.Rule {
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkFloatingDecimal(ana, node)
+	}
+
+	return one.CreateRule("js-no-floating-decimal", "number", one.LangJs, &entry, nil)
+}
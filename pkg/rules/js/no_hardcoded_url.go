@@ -0,0 +1,107 @@
+package js_rules
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoHardcodedUrlOptions struct {
+	// Allow is a list of hosts that can be hardcoded, in addition to
+	// `localhost`, loopback addresses, and the `example.*` domains.
+	// Subdomains of an allowed host are allowed too.
+	Allow []string
+}
+
+var defaultAllowedHosts = []string{
+	"localhost",
+	"127.0.0.1",
+	"0.0.0.0",
+	"::1",
+	"example.com",
+	"example.org",
+	"example.net",
+}
+
+var (
+	urlRegexp = regexp.MustCompile(`\b[a-zA-Z][a-zA-Z0-9+.-]*://[^\s'"` + "`" + `]+`)
+	ipRegexp  = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+)
+
+func isAllowedHost(allowed []string, host string) bool {
+	host = strings.ToLower(host)
+	return slices.ContainsFunc(allowed, func(allowed string) bool {
+		return host == allowed || strings.HasSuffix(host, "."+allowed)
+	})
+}
+
+// hardcodedValue returns the first URL or IP address in `text` whose host isn't allowed.
+func hardcodedValue(allowed []string, text string) (value string, kind string) {
+	for _, match := range urlRegexp.FindAllString(text, -1) {
+		u, err := url.Parse(match)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+
+		if !isAllowedHost(allowed, u.Hostname()) {
+			return match, "URL"
+		}
+	}
+
+	// IP addresses that are part of a URL were checked above.
+	text = urlRegexp.ReplaceAllString(text, "")
+	for _, match := range ipRegexp.FindAllString(text, -1) {
+		if net.ParseIP(match) != nil && !isAllowedHost(allowed, match) {
+			return match, "IP address"
+		}
+	}
+
+	return "", ""
+}
+
+func checkHardcodedUrl(allowed []string, ana *one.Analyzer, node *sitter.Node) {
+	// import x from "https://deno.land/..."
+	if parent := node.Parent(); parent != nil && (parent.Type() == "import_statement" || parent.Type() == "export_statement") {
+		return
+	}
+
+	value, kind := hardcodedValue(allowed, node.Content(ana.ParseResult.Source))
+	if value == "" {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message:  fmt.Sprintf("Hardcoded %s '%s'. Consider reading it from configuration.", kind, value),
+		Severity: one.SeverityInfo,
+		Range:    node.Range(),
+		Node:     node,
+	})
+}
+
+// NoHardcodedUrl flags string literals that contain URLs or IP addresses,
+// which usually differ between environments and belong in configuration.
+// When `opts` is nil, the default options are used.
+func NoHardcodedUrl(opts *NoHardcodedUrlOptions) one.Rule {
+	allowed := defaultAllowedHosts
+	if opts != nil {
+		allowed = append(slices.Clone(defaultAllowedHosts), opts.Allow...)
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkHardcodedUrl(allowed, ana, node)
+	}
+
+	return one.CreateMultiNodeRule(
+		"js-no-hardcoded-url",
+		[]string{"string", "template_string"},
+		one.LangJs,
+		&entry,
+		nil,
+	)
+}
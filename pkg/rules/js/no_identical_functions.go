@@ -0,0 +1,164 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoIdenticalFunctionsOptions struct {
+	// MinLines is the number of lines a function body must span to be compared.
+	// Defaults to 3 when 0, so that one-liners like getters aren't flagged.
+	MinLines int
+}
+
+const defaultMinIdenticalLines = 3
+
+var identifierTypes = []string{"identifier", "property_identifier", "shorthand_property_identifier", "type_identifier"}
+
+// structureWriter writes the structure of a sub-tree as an S-expression that is
+// the same for code that only differs in whitespace, comments, or the names of identifiers.
+// Identifiers are numbered by first appearance, so `a + a` and `a + b` still differ.
+type structureWriter struct {
+	sb     strings.Builder
+	source []byte
+	names  map[string]int
+}
+
+func (w *structureWriter) write(node *sitter.Node) {
+	if node.Type() == "comment" {
+		return
+	}
+
+	w.sb.WriteString("(")
+	w.sb.WriteString(node.Type())
+	switch {
+	case slices.Contains(identifierTypes, node.Type()):
+		name := node.Content(w.source)
+		if _, ok := w.names[name]; !ok {
+			w.names[name] = len(w.names)
+		}
+
+		w.sb.WriteString(" $")
+		w.sb.WriteString(strconv.Itoa(w.names[name]))
+
+	case node.ChildCount() == 0:
+		// literals and operators
+		w.sb.WriteString(" ")
+		w.sb.WriteString(strconv.Quote(node.Content(w.source)))
+	}
+
+	for i := 0; i < int(node.ChildCount()); i++ {
+		w.write(node.Child(i))
+	}
+
+	w.sb.WriteString(")")
+}
+
+// bodyStructure returns the normalized S-expression of the body of `fn`.
+func bodyStructure(fn *sitter.Node, source []byte) string {
+	w := &structureWriter{source: source, names: map[string]int{}}
+	if params := fn.ChildByFieldName("parameters"); params != nil {
+		w.write(params)
+	} else if param := fn.ChildByFieldName("parameter"); param != nil {
+		w.write(param)
+	}
+
+	w.write(fn.ChildByFieldName("body"))
+	return w.sb.String()
+}
+
+func describeFunction(fn *sitter.Node, source []byte) string {
+	if name := functionNameNode(fn); name != nil {
+		return fmt.Sprintf("'%s'", name.Content(source))
+	}
+
+	return "the function"
+}
+
+func collectFunction(functionsKey string, ana *one.Analyzer, node *sitter.Node) {
+	// the file hook runs with the root node.
+	if node.Parent() == nil {
+		ana.Set(functionsKey, []*sitter.Node{})
+		return
+	}
+
+	if node.ChildByFieldName("body") == nil {
+		return
+	}
+
+	value, _ := ana.Get(functionsKey)
+	functions, _ := value.([]*sitter.Node)
+	ana.Set(functionsKey, append(functions, node))
+}
+
+func checkIdenticalFunctions(opts *NoIdenticalFunctionsOptions, functionsKey string, ana *one.Analyzer, node *sitter.Node) {
+	if node.Parent() != nil {
+		return
+	}
+
+	minLines := opts.MinLines
+	if minLines == 0 {
+		minLines = defaultMinIdenticalLines
+	}
+
+	source := ana.ParseResult.Source
+	value, _ := ana.Get(functionsKey)
+	functions, _ := value.([]*sitter.Node)
+	firstWithStructure := map[string]*sitter.Node{}
+	for _, fn := range functions {
+		body := fn.ChildByFieldName("body")
+		if int(body.EndPoint().Row-body.StartPoint().Row)+1 < minLines {
+			continue
+		}
+
+		structure := bodyStructure(fn, source)
+		original, ok := firstWithStructure[structure]
+		if !ok {
+			firstWithStructure[structure] = fn
+			continue
+		}
+
+		issue := &one.Issue{
+			Message: fmt.Sprintf("This function is identical to %s on line %d",
+				describeFunction(original, source), original.StartPoint().Row+1),
+			Range: fn.Range(),
+			Node:  fn,
+			Related: []one.RelatedLocation{{
+				Message: "Original function",
+				Range:   original.Range(),
+			}},
+		}
+
+		if name := functionNameNode(fn); name != nil {
+			issue.Range = name.Range()
+		}
+
+		ana.Report(issue)
+	}
+}
+
+// NoIdenticalFunctions flags functions whose bodies are identical to an earlier function
+// in the same file, except for whitespace, comments, and the names of identifiers.
+// When `opts` is nil, the default options are used.
+func NoIdenticalFunctions(opts *NoIdenticalFunctionsOptions) one.Rule {
+	if opts == nil {
+		opts = &NoIdenticalFunctionsOptions{}
+	}
+
+	functionsKey := instanceKey("js-no-identical-functions/functions")
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		collectFunction(functionsKey, ana, node)
+	}
+
+	var exit one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkIdenticalFunctions(opts, functionsKey, ana, node)
+	}
+
+	nodeTypes := append([]string{one.FileNodeType}, functionNodeTypes...)
+	return one.CreateMultiNodeRule("js-no-identical-functions", nodeTypes, one.LangJs, &entry, &exit)
+}
@@ -0,0 +1,99 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoImplicitAnyOptions struct {
+	// CheckDefaulted also reports parameters with a default value,
+	// whose type TypeScript could otherwise infer from the default.
+	CheckDefaulted bool
+}
+
+// isContextuallyTyped returns true if the parameter types of `function` can be
+// inferred from where it is used. e.g: `arr.map(x => x)`, or `const f: Handler = x => x`.
+func isContextuallyTyped(function *sitter.Node) bool {
+	parent := function.Parent()
+	if parent == nil {
+		return false
+	}
+
+	switch parent.Type() {
+	case "arguments":
+		return true
+	case "variable_declarator":
+		return parent.ChildByFieldName("type") != nil
+	default:
+		return false
+	}
+}
+
+func reportImplicitAny(ana *one.Analyzer, param, pattern *sitter.Node) {
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("Parameter '%s' implicitly has an 'any' type", pattern.Content(ana.ParseResult.Source)),
+		Range:   param.Range(),
+	})
+}
+
+func checkImplicitAny(opts *NoImplicitAnyOptions, ana *one.Analyzer, node *sitter.Node) {
+	if node.Type() == "arrow_function" {
+		// x => ...
+		param := node.ChildByFieldName("parameter")
+		if param != nil && !isContextuallyTyped(node) {
+			reportImplicitAny(ana, param, param)
+		}
+		return
+	}
+
+	// formal_parameters
+	function := node.Parent()
+	if function == nil || isContextuallyTyped(function) {
+		return
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		param := node.NamedChild(i)
+		if param.Type() != "required_parameter" && param.Type() != "optional_parameter" {
+			continue
+		}
+
+		if param.ChildByFieldName("type") != nil {
+			continue
+		}
+
+		if param.ChildByFieldName("value") != nil && !opts.CheckDefaulted {
+			continue
+		}
+
+		pattern := param.ChildByFieldName("pattern")
+		if pattern == nil {
+			continue
+		}
+
+		reportImplicitAny(ana, param, pattern)
+	}
+}
+
+// NoImplicitAny flags parameters of TypeScript functions that have no type annotation.
+// Functions whose parameter types are inferred from context (e.g: callbacks) are skipped.
+// When `opts` is nil, the default options are used.
+func NoImplicitAny(opts *NoImplicitAnyOptions) one.Rule {
+	if opts == nil {
+		opts = &NoImplicitAnyOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkImplicitAny(opts, ana, node)
+	}
+
+	return one.CreateMultiNodeRule(
+		"ts-no-implicit-any",
+		[]string{"formal_parameters", "arrow_function"},
+		one.LangTs,
+		&entry,
+		nil,
+	)
+}
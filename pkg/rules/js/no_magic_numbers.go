@@ -0,0 +1,104 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoMagicNumbersOptions struct {
+	// Allowed is the list of numbers that are never reported.
+	// When nil, defaults to `-1, 0, 1, 2`.
+	Allowed []float64
+	// IgnoreArrayIndexes allows numbers used as indices, e.g: `arr[3]`.
+	IgnoreArrayIndexes bool
+}
+
+var defaultAllowedNumbers = []float64{-1, 0, 1, 2}
+
+// parseNumber returns the numeric value of a number literal.
+// `ok` is false for literals that can't be represented as a float64.
+func parseNumber(text string) (value float64, ok bool) {
+	text = strings.ReplaceAll(text, "_", "")
+	text = strings.TrimSuffix(text, "n") // BigInt
+
+	if len(text) > 2 && text[0] == '0' && strings.ContainsRune("xXoObB", rune(text[1])) {
+		n, err := strconv.ParseInt(text, 0, 64)
+		return float64(n), err == nil
+	}
+
+	f, err := strconv.ParseFloat(text, 64)
+	return f, err == nil
+}
+
+// isConstInitializer returns true if `node` is the value of a `const` declaration.
+func isConstInitializer(node *sitter.Node) bool {
+	parent := node.Parent()
+	if parent == nil || parent.Type() != "variable_declarator" || parent.ChildByFieldName("value") != node {
+		return false
+	}
+
+	decl := parent.Parent()
+	return decl != nil && decl.Type() == "lexical_declaration" && decl.Child(0).Type() == "const"
+}
+
+func checkMagicNumber(opts *NoMagicNumbersOptions, ana *one.Analyzer, node *sitter.Node) {
+	source := ana.ParseResult.Source
+	value, ok := parseNumber(node.Content(source))
+	if !ok {
+		return
+	}
+
+	// `-1` is parsed as a unary expression wrapping `1`
+	literal := node
+	if parent := node.Parent(); parent != nil && parent.Type() == "unary_expression" &&
+		parent.Child(0).Content(source) == "-" {
+		value = -value
+		literal = parent
+	}
+
+	allowed := opts.Allowed
+	if allowed == nil {
+		allowed = defaultAllowedNumbers
+	}
+
+	if slices.Contains(allowed, value) || isConstInitializer(literal) {
+		return
+	}
+
+	parent := literal.Parent()
+	if parent != nil {
+		switch parent.Type() {
+		case "literal_type", "enum_assignment":
+			return
+		case "subscript_expression":
+			if opts.IgnoreArrayIndexes && parent.ChildByFieldName("index") == literal {
+				return
+			}
+		}
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("Magic number '%s'. Extract it into a named constant", literal.Content(source)),
+		Range:   literal.Range(),
+	})
+}
+
+// NoMagicNumbers flags numeric literals that are not in an allow-list,
+// unless they are used to initialize a constant.
+// When `opts` is nil, the default options are used.
+func NoMagicNumbers(opts *NoMagicNumbersOptions) one.Rule {
+	if opts == nil {
+		opts = &NoMagicNumbersOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkMagicNumber(opts, ana, node)
+	}
+
+	return one.CreateRule("js-no-magic-numbers", "number", one.LangJs, &entry, nil)
+}
@@ -0,0 +1,110 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoMixedOperatorsOptions struct {
+	// Groups are sets of operators that can't be mixed with each other without parentheses.
+	// e.g: with the group `{"&&", "||"}`, `a && b || c` is reported, but `a && b + c` isn't.
+	// When set, these replace the default groups.
+	Groups [][]string
+	// CheckSamePrecedence also reports operators that have the same precedence,
+	// like `a == b != c`. A chain of the same operator (`a + b + c`) is never reported.
+	CheckSamePrecedence bool
+}
+
+var defaultOperatorGroups = [][]string{
+	// logical
+	{"&&", "||", "??"},
+	// bitwise
+	{"&", "|", "^", "<<", ">>", ">>>"},
+	// shifts with addition, like `a + b << c`
+	{"+", "-", "<<", ">>", ">>>"},
+	// bitwise with comparison, like `flags & MASK == 0`, which is parsed as `flags & (MASK == 0)`
+	{"&", "|", "^", "==", "!=", "===", "!==", "<", "<=", ">", ">="},
+}
+
+// operatorPrecedence is the precedence of every binary operator that can appear in a group.
+// Operators with a higher precedence bind tighter.
+var operatorPrecedence = map[string]int{
+	"??": 1,
+	"||": 2,
+	"&&": 3,
+	"|":  4,
+	"^":  5,
+	"&":  6,
+	"==": 7, "!=": 7, "===": 7, "!==": 7,
+	"<": 8, "<=": 8, ">": 8, ">=": 8, "in": 8, "instanceof": 8,
+	"<<": 9, ">>": 9, ">>>": 9,
+	"+": 10, "-": 10,
+	"*": 11, "/": 11, "%": 11,
+	"**": 12,
+}
+
+// isConfusingMix returns true if the operators `a` and `b` need parentheses when used together.
+func isConfusingMix(opts *NoMixedOperatorsOptions, groups [][]string, a, b string) bool {
+	if a == b {
+		return false
+	}
+
+	if !opts.CheckSamePrecedence && operatorPrecedence[a] == operatorPrecedence[b] {
+		return false
+	}
+
+	return slices.ContainsFunc(groups, func(group []string) bool {
+		return slices.Contains(group, a) && slices.Contains(group, b)
+	})
+}
+
+func checkMixedOperators(opts *NoMixedOperatorsOptions, groups [][]string, ana *one.Analyzer, node *sitter.Node) {
+	operator := operatorOf(node)
+	for _, field := range []string{"left", "right"} {
+		// operands in parentheses are `parenthesized_expression`s, not `binary_expression`s.
+		operand := node.ChildByFieldName(field)
+		if operand == nil || operand.Type() != "binary_expression" {
+			continue
+		}
+
+		inner := operatorOf(operand)
+		if !isConfusingMix(opts, groups, operator, inner) {
+			continue
+		}
+
+		first, second := inner, operator
+		if field == "right" {
+			first, second = operator, inner
+		}
+
+		ana.Report(&one.Issue{
+			Message: fmt.Sprintf("Unexpected mix of '%s' and '%s'. Use parentheses to make the order of evaluation clear", first, second),
+			Range:   node.Range(),
+			Node:    node,
+		})
+		return
+	}
+}
+
+// NoMixedOperators flags binary expressions that mix operators of different precedence
+// without parentheses, like `a && b || c`, where the order of evaluation isn't obvious.
+// When `opts` is nil, the default options are used.
+func NoMixedOperators(opts *NoMixedOperatorsOptions) one.Rule {
+	if opts == nil {
+		opts = &NoMixedOperatorsOptions{}
+	}
+
+	groups := defaultOperatorGroups
+	if len(opts.Groups) > 0 {
+		groups = opts.Groups
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkMixedOperators(opts, groups, ana, node)
+	}
+
+	return one.CreateRule("js-no-mixed-operators", "binary_expression", one.LangJs, &entry, nil)
+}
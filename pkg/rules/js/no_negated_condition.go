@@ -0,0 +1,142 @@
+package js_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoNegatedConditionOptions struct {
+	// IncludeTernaries also flags conditional expressions like `!a ? b : c`.
+	IncludeTernaries bool
+	// IgnoreInequality doesn't flag conditions like `a !== b`, only `!a`.
+	IgnoreInequality bool
+}
+
+var positiveOperators = map[string]string{"!=": "==", "!==": "==="}
+
+// positiveCondition returns the text of `condition` without its negation,
+// or false if the condition isn't negated.
+func positiveCondition(opts *NoNegatedConditionOptions, condition *sitter.Node, source []byte) (string, bool) {
+	switch condition.Type() {
+	case "unary_expression":
+		argument := condition.ChildByFieldName("argument")
+		if operatorOf(condition) != "!" || argument == nil {
+			return "", false
+		}
+
+		// `!!x` is a conversion to boolean, not a negation.
+		if argument.Type() == "unary_expression" && operatorOf(argument) == "!" {
+			return "", false
+		}
+
+		if condition.Parent().Type() == "parenthesized_expression" {
+			argument = unwrapParens(argument)
+		}
+
+		return argument.Content(source), true
+
+	case "binary_expression":
+		operator := condition.ChildByFieldName("operator")
+		positive, ok := positiveOperators[operatorOf(condition)]
+		if opts.IgnoreInequality || !ok {
+			return "", false
+		}
+
+		before := source[condition.StartByte():operator.StartByte()]
+		after := source[operator.EndByte():condition.EndByte()]
+		return string(before) + positive + string(after), true
+
+	default:
+		return "", false
+	}
+}
+
+// swapBranchesFix returns a fix that replaces `condition` with `positive`,
+// and swaps `first` and `second`, which come after it.
+func swapBranchesFix(condition *sitter.Node, positive string, first, second *sitter.Node, source []byte) *one.Fix {
+	replacement := positive +
+		string(source[condition.EndByte():first.StartByte()]) +
+		second.Content(source) +
+		string(source[first.EndByte():second.StartByte()]) +
+		first.Content(source)
+
+	return &one.Fix{
+		Range: sitter.Range{
+			StartPoint: condition.StartPoint(),
+			EndPoint:   second.EndPoint(),
+			StartByte:  condition.StartByte(),
+			EndByte:    second.EndByte(),
+		},
+		Replacement: replacement,
+	}
+}
+
+func checkNegatedCondition(opts *NoNegatedConditionOptions, ana *one.Analyzer, node *sitter.Node) {
+	var condition, first, second *sitter.Node
+	switch node.Type() {
+	case "if_statement":
+		alternative := node.ChildByFieldName("alternative")
+		if alternative == nil || alternative.NamedChildCount() == 0 {
+			return
+		}
+
+		second = alternative.NamedChild(0)
+		// `else if` chains can't be swapped.
+		if second.Type() == "if_statement" {
+			return
+		}
+
+		condition = node.ChildByFieldName("condition")
+		if condition != nil && condition.Type() == "parenthesized_expression" {
+			condition = condition.NamedChild(0)
+		}
+		first = node.ChildByFieldName("consequence")
+
+	case "ternary_expression":
+		if !opts.IncludeTernaries {
+			return
+		}
+
+		condition = node.ChildByFieldName("condition")
+		first, second = node.ChildByFieldName("consequence"), node.ChildByFieldName("alternative")
+	}
+
+	if condition == nil || first == nil || second == nil {
+		return
+	}
+
+	source := ana.ParseResult.Source
+	positive, ok := positiveCondition(opts, condition, source)
+	if !ok {
+		return
+	}
+
+	issue := &one.Issue{
+		Message: "Unexpected negated condition. Consider swapping the branches and removing the negation",
+		Range:   condition.Range(),
+		Node:    condition,
+	}
+
+	// statements without braces may depend on ASI, so only blocks are swapped.
+	if node.Type() == "ternary_expression" || (first.Type() == "statement_block" && second.Type() == "statement_block") {
+		issue.Fix = swapBranchesFix(condition, positive, first, second, source)
+	}
+
+	ana.Report(issue)
+}
+
+// NoNegatedCondition flags `if` statements with a negated condition and an `else` branch,
+// like `if (!x) { a() } else { b() }`, which read better with the branches swapped.
+// When `opts` is nil, the default options are used.
+func NoNegatedCondition(opts *NoNegatedConditionOptions) one.Rule {
+	if opts == nil {
+		opts = &NoNegatedConditionOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkNegatedCondition(opts, ana, node)
+	}
+
+	nodeTypes := []string{"if_statement", "ternary_expression"}
+	return one.CreateMultiNodeRule("js-no-negated-condition", nodeTypes, one.LangJs, &entry, nil)
+}
@@ -0,0 +1,61 @@
+package js_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoNestedTernaryOptions struct {
+	// MaxDepth is the number of levels that ternaries can be nested.
+	// When 0, any nesting is reported.
+	MaxDepth int
+}
+
+// ternaryDepth returns the number of ternaries that have `ternary`
+// nested in their consequent or alternate.
+func ternaryDepth(ternary *sitter.Node) int {
+	depth := 0
+	node := ternary
+	for {
+		parent := node.Parent()
+		for parent != nil && parent.Type() == "parenthesized_expression" {
+			node, parent = parent, parent.Parent()
+		}
+
+		if parent == nil || parent.Type() != "ternary_expression" {
+			return depth
+		}
+
+		if parent.ChildByFieldName("condition") == node {
+			return depth
+		}
+
+		depth++
+		node = parent
+	}
+}
+
+func checkNestedTernary(opts *NoNestedTernaryOptions, ana *one.Analyzer, node *sitter.Node) {
+	// only the outermost ternary that is nested too deep is reported
+	if ternaryDepth(node) == opts.MaxDepth+1 {
+		ana.Report(&one.Issue{
+			Message: "Do not nest ternary expressions. Use an if-else statement instead",
+			Range:   node.Range(),
+		})
+	}
+}
+
+// NoNestedTernary flags ternary expressions that are nested inside the
+// consequent or alternate of another ternary.
+// When `opts` is nil, the default options are used.
+func NoNestedTernary(opts *NoNestedTernaryOptions) one.Rule {
+	if opts == nil {
+		opts = &NoNestedTernaryOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkNestedTernary(opts, ana, node)
+	}
+
+	return one.CreateRule("js-no-nested-ternary", "ternary_expression", one.LangJs, &entry, nil)
+}
@@ -0,0 +1,111 @@
+package js_rules
+
+import (
+	"fmt"
+	"sort"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoParamReassignOptions struct {
+	// Props also flags writes to properties of parameters, like `param.x = 1`.
+	Props bool
+}
+
+// propertyWrite returns the assignment or update expression that writes to
+// a property of `id`, like `id.x = 1` or `id[0]++`, or nil if there is none.
+func propertyWrite(id *sitter.Node) *sitter.Node {
+	target := id
+	parent := id.Parent()
+	for parent != nil && (parent.Type() == "member_expression" || parent.Type() == "subscript_expression") {
+		if parent.ChildByFieldName("object") != target {
+			return nil
+		}
+
+		target, parent = parent, parent.Parent()
+	}
+
+	if target == id || parent == nil {
+		return nil
+	}
+
+	switch parent.Type() {
+	case "assignment_expression", "augmented_assignment_expression":
+		if parent.ChildByFieldName("left") == target {
+			return parent
+		}
+	case "update_expression":
+		return parent
+	}
+
+	return nil
+}
+
+func paramWrites(opts *NoParamReassignOptions, param *one.Variable) []*sitter.Node {
+	var writes []*sitter.Node
+	for _, ref := range param.Refs {
+		if ref.IsWriteRef {
+			writes = append(writes, ref.Node.Parent())
+			continue
+		}
+
+		if !opts.Props {
+			continue
+		}
+
+		if write := propertyWrite(ref.Node); write != nil {
+			writes = append(writes, write)
+		}
+	}
+
+	return writes
+}
+
+func checkParamReassign(opts *NoParamReassignOptions, ana *one.Analyzer, scope *one.Scope) {
+	var issues []*one.Issue
+	for name, variable := range scope.Variables {
+		if variable.Kind != one.VarKindParameter {
+			continue
+		}
+
+		for _, write := range paramWrites(opts, variable) {
+			issues = append(issues, &one.Issue{
+				Message: fmt.Sprintf("Assignment to function parameter '%s'", name),
+				Range:   write.Range(),
+				Related: []one.RelatedLocation{{
+					Message: fmt.Sprintf("'%s' is declared here", name),
+					Range:   variable.DeclNode.Range(),
+				}},
+			})
+		}
+	}
+
+	sort.Slice(issues, func(i, j int) bool {
+		return issues[i].Range.StartByte < issues[j].Range.StartByte
+	})
+
+	for _, issue := range issues {
+		ana.Report(issue)
+	}
+
+	for _, child := range scope.Children {
+		checkParamReassign(opts, ana, child)
+	}
+}
+
+// NoParamReassign flags assignments to function parameters.
+// When `opts` is nil, the default options are used.
+func NoParamReassign(opts *NoParamReassignOptions) one.Rule {
+	if opts == nil {
+		opts = &NoParamReassignOptions{}
+	}
+
+	var exit one.VisitFn = func(r one.Rule, ana *one.Analyzer, root *sitter.Node) {
+		if scopeTree := ana.ParseResult.ScopeTree; scopeTree != nil {
+			checkParamReassign(opts, ana, scopeTree.Root)
+		}
+	}
+
+	return one.CreateRule("js-no-param-reassign", one.FileNodeType, one.LangJs, nil, &exit)
+}
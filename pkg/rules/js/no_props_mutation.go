@@ -0,0 +1,135 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoPropsMutationOptions struct {
+	// Methods is a list of methods that mutate the object they're called on,
+	// in addition to the mutating methods of arrays (like `push` and `sort`).
+	Methods []string
+}
+
+var defaultMutatingMethods = []string{
+	"push", "pop", "shift", "unshift", "splice", "sort", "reverse", "fill", "copyWithin",
+}
+
+// isPropsObject returns true if `node` is `this.props`, or a parameter named `props`.
+func isPropsObject(ana *one.Analyzer, node *sitter.Node) bool {
+	source := ana.ParseResult.Source
+	switch node.Type() {
+	case "member_expression":
+		object, property := node.ChildByFieldName("object"), node.ChildByFieldName("property")
+		return object != nil && property != nil && object.Type() == "this" && property.Content(source) == "props"
+
+	case "identifier":
+		if node.Content(source) != "props" || ana.ParseResult.ScopeTree == nil {
+			return false
+		}
+
+		scope := ana.ParseResult.ScopeTree.GetScope(node)
+		if scope == nil {
+			return false
+		}
+
+		variable := scope.Lookup("props")
+		return variable != nil && variable.Kind == one.VarKindParameter
+	}
+
+	return false
+}
+
+// isInsideProps returns true if `node` is a property of the props object, like
+// `props.items`, `this.props.user.name` or `props[key]`.
+func isInsideProps(ana *one.Analyzer, node *sitter.Node) bool {
+	for node.Type() == "member_expression" || node.Type() == "subscript_expression" {
+		object := node.ChildByFieldName("object")
+		if object == nil {
+			return false
+		}
+
+		if isPropsObject(ana, object) {
+			return true
+		}
+
+		node = object
+	}
+
+	return false
+}
+
+// mutatedObject returns the expression that `node` mutates, if any.
+func mutatedObject(methods []string, node *sitter.Node, source []byte) *sitter.Node {
+	switch node.Type() {
+	case "assignment_expression", "augmented_assignment_expression":
+		return node.ChildByFieldName("left")
+
+	case "update_expression":
+		return node.ChildByFieldName("argument")
+
+	case "unary_expression":
+		if operatorOf(node) == "delete" {
+			return node.ChildByFieldName("argument")
+		}
+
+	case "call_expression":
+		// props.items.push(item)
+		callee := node.ChildByFieldName("function")
+		if callee == nil || callee.Type() != "member_expression" {
+			return nil
+		}
+
+		property := callee.ChildByFieldName("property")
+		if property != nil && slices.Contains(methods, property.Content(source)) {
+			return callee.ChildByFieldName("object")
+		}
+	}
+
+	return nil
+}
+
+func checkPropsMutation(methods []string, ana *one.Analyzer, node *sitter.Node) {
+	source := ana.ParseResult.Source
+	target := mutatedObject(methods, node, source)
+	if target == nil {
+		return
+	}
+
+	// `props.items.push()` mutates `props.items`, but `props.x = 1` mutates `props` itself.
+	if !isInsideProps(ana, target) && !(node.Type() == "call_expression" && isPropsObject(ana, target)) {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("Props are read-only. Do not mutate '%s'", target.Content(source)),
+		Range:   node.Range(),
+		Node:    node,
+	})
+}
+
+// NoPropsMutation flags code that mutates the props of a React component,
+// like `this.props.x = 1` or `props.items.push(item)`.
+// When `opts` is nil, the default options are used.
+func NoPropsMutation(opts *NoPropsMutationOptions) one.Rule {
+	methods := defaultMutatingMethods
+	if opts != nil {
+		methods = append(slices.Clone(defaultMutatingMethods), opts.Methods...)
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkPropsMutation(methods, ana, node)
+	}
+
+	nodeTypes := []string{
+		"assignment_expression",
+		"augmented_assignment_expression",
+		"update_expression",
+		"unary_expression",
+		"call_expression",
+	}
+	return one.CreateMultiNodeRule("js-no-props-mutation", nodeTypes, one.LangJs, &entry, nil)
+}
@@ -0,0 +1,68 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// expressions that can be negated with `!` without wrapping them in parentheses.
+var primaryExpressionTypes = []string{
+	"identifier", "member_expression", "subscript_expression",
+	"call_expression", "parenthesized_expression", "this",
+}
+
+func checkRedundantBoolean(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+	operator := node.ChildByFieldName("operator").Type()
+	if operator != "===" && operator != "!==" && operator != "==" && operator != "!=" {
+		return
+	}
+
+	left, right := node.ChildByFieldName("left"), node.ChildByFieldName("right")
+	if left == nil || right == nil {
+		return
+	}
+
+	literal, operand := right, left
+	if left.Type() == "true" || left.Type() == "false" {
+		literal, operand = left, right
+	}
+
+	if literal.Type() != "true" && literal.Type() != "false" {
+		return
+	}
+
+	source := ana.ParseResult.Source
+	simplified := operand.Content(source)
+	// `x === false` and `x !== true` are both `!x`
+	if (literal.Type() == "true") != (operator == "===" || operator == "==") {
+		if slices.Contains(primaryExpressionTypes, operand.Type()) {
+			simplified = "!" + simplified
+		} else {
+			simplified = "!(" + simplified + ")"
+		}
+	}
+
+	// `x === true` is only the same as `x` when `x` is a boolean: `if (1 === true)` doesn't run,
+	// but `if (1)` does. The type of `x` isn't known here, so there is no fix.
+	// `x == true` also converts `x` to a number first, so `'1' == true` is true.
+	message := fmt.Sprintf("Unnecessary comparison to '%s'. If the value is always a boolean, simplify to '%s'",
+		literal.Content(source), simplified)
+	if operator == "==" || operator == "!=" {
+		message = fmt.Sprintf(
+			"'%s' converts both sides to numbers before comparing to '%s'. Use '%s' to check if the value is truthy",
+			operator, literal.Content(source), simplified,
+		)
+	}
+
+	ana.Report(&one.Issue{Message: message, Range: node.Range()})
+}
+
+// NoRedundantBoolean flags comparisons to boolean literals, like `x === true`.
+// Issues suggest a simpler form, but have no fix, since it is only equivalent for booleans.
+func NoRedundantBoolean() one.Rule {
+	var entry one.VisitFn = checkRedundantBoolean
+	return one.CreateRule("js-no-redundant-boolean", "binary_expression", one.LangJs, &entry, nil)
+}
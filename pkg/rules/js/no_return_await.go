@@ -0,0 +1,85 @@
+package js_rules
+
+import (
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+var functionNodeTypes = []string{
+	"function_declaration",
+	"generator_function_declaration",
+	"function_expression",
+	"function",
+	"generator_function",
+	"arrow_function",
+	"method_definition",
+}
+
+// awaitIsMeaningful reports whether a `return await` at `node` changes behaviour,
+// i.e: a rejection would otherwise escape an enclosing try/catch/finally
+// in the same function.
+func awaitIsMeaningful(node *sitter.Node) bool {
+	for child, parent := node, node.Parent(); parent != nil; child, parent = parent, parent.Parent() {
+		if slices.Contains(functionNodeTypes, parent.Type()) {
+			return false
+		}
+
+		if parent.Type() != "try_statement" {
+			continue
+		}
+
+		if body := parent.ChildByFieldName("body"); body != nil && child.Equal(body) {
+			return true
+		}
+
+		// the finally block still has to run after the awaited promise settles.
+		handler := parent.ChildByFieldName("handler")
+		if handler != nil && child.Equal(handler) && parent.ChildByFieldName("finalizer") != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkReturnAwait(ana *one.Analyzer, node *sitter.Node) {
+	value := node.NamedChild(0)
+	for value != nil && value.Type() == "parenthesized_expression" {
+		value = value.NamedChild(0)
+	}
+
+	if value == nil || value.Type() != "await_expression" || value.NamedChildCount() == 0 {
+		return
+	}
+
+	if awaitIsMeaningful(node) {
+		return
+	}
+
+	keyword := value.Child(0)
+	argument := value.NamedChild(0)
+	ana.Report(&one.Issue{
+		Message: "Redundant use of 'await' on a return value",
+		Range:   keyword.Range(),
+		Fix: &one.Fix{
+			Range: sitter.Range{
+				StartPoint: keyword.StartPoint(),
+				EndPoint:   argument.StartPoint(),
+				StartByte:  keyword.StartByte(),
+				EndByte:    argument.StartByte(),
+			},
+		},
+	})
+}
+
+// NoReturnAwait flags `return await` outside of try/catch/finally blocks,
+// where the `await` is redundant.
+func NoReturnAwait() one.Rule {
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkReturnAwait(ana, node)
+	}
+
+	return one.CreateRule("js-no-return-await", "return_statement", one.LangJs, &entry, nil)
+}
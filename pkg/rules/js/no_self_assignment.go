@@ -0,0 +1,120 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoSelfAssignmentOptions struct {
+	// AllowProperties ignores self-assignments of properties, like `this.a = this.a`,
+	// which aren't no-ops when the property has a getter or a setter.
+	AllowProperties bool
+}
+
+// selfAssignmentOperators are the compound assignments that
+// don't change a variable when it's assigned to itself.
+var selfAssignmentOperators = []string{"&&=", "||=", "??="}
+
+// sideEffectTypes are expressions that may do something different
+// every time they're evaluated, like `a[i++]`.
+var sideEffectTypes = []string{
+	"call_expression",
+	"new_expression",
+	"update_expression",
+	"assignment_expression",
+	"augmented_assignment_expression",
+	"await_expression",
+	"yield_expression",
+}
+
+func hasSideEffects(node *sitter.Node) bool {
+	if slices.Contains(sideEffectTypes, node.Type()) {
+		return true
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if hasSideEffects(node.NamedChild(i)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizedText returns the tokens in `node` separated by spaces,
+// so that `a[ 0 ]` and `a[0]` are the same. Comments are left out.
+func normalizedText(node *sitter.Node, source []byte) string {
+	var tokens []string
+	var collect func(node *sitter.Node)
+	collect = func(node *sitter.Node) {
+		if node.Type() == "comment" {
+			return
+		}
+
+		// strings are compared as a whole, without splitting them into fragments.
+		if node.ChildCount() == 0 || node.Type() == "string" {
+			tokens = append(tokens, node.Content(source))
+			return
+		}
+
+		for i := 0; i < int(node.ChildCount()); i++ {
+			collect(node.Child(i))
+		}
+	}
+
+	collect(node)
+	return strings.Join(tokens, " ")
+}
+
+func checkSelfAssignment(opts *NoSelfAssignmentOptions, ana *one.Analyzer, node *sitter.Node) {
+	if node.Type() == "augmented_assignment_expression" && !slices.Contains(selfAssignmentOperators, operatorOf(node)) {
+		return
+	}
+
+	left, right := node.ChildByFieldName("left"), node.ChildByFieldName("right")
+	if left == nil || right == nil {
+		return
+	}
+
+	switch left.Type() {
+	case "identifier":
+	case "member_expression", "subscript_expression":
+		if opts.AllowProperties || hasSideEffects(left) {
+			return
+		}
+	default:
+		return
+	}
+
+	source := ana.ParseResult.Source
+	target := normalizedText(left, source)
+	if target != normalizedText(unwrapParens(right), source) {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("'%s' is assigned to itself", left.Content(source)),
+		Range:   node.Range(),
+		Node:    node,
+	})
+}
+
+// NoSelfAssignment flags assignments whose both sides are the same, like `x = x`,
+// which have no effect and are usually a typo.
+// When `opts` is nil, the default options are used.
+func NoSelfAssignment(opts *NoSelfAssignmentOptions) one.Rule {
+	if opts == nil {
+		opts = &NoSelfAssignmentOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkSelfAssignment(opts, ana, node)
+	}
+
+	nodeTypes := []string{"assignment_expression", "augmented_assignment_expression"}
+	return one.CreateMultiNodeRule("js-no-self-assignment", nodeTypes, one.LangJs, &entry, nil)
+}
@@ -0,0 +1,79 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoShadowBuiltinsOptions struct {
+	// Builtins is a list of names to check for, in addition to the default built-in globals.
+	Builtins []string
+}
+
+var defaultBuiltins = []string{
+	"Array", "ArrayBuffer", "BigInt", "Boolean", "DataView", "Date", "Error",
+	"Function", "Infinity", "Intl", "JSON", "Map", "Math", "NaN", "Number",
+	"Object", "Promise", "Proxy", "Reflect", "RegExp", "Set", "String",
+	"Symbol", "WeakMap", "WeakSet", "eval", "globalThis", "isFinite",
+	"isNaN", "parseFloat", "parseInt", "undefined",
+}
+
+// shadowingVars collects all variables declared in `scope` and its children
+// that shadow a builtin.
+func shadowingVars(scope *one.Scope, builtins []string, vars []*one.Variable) []*one.Variable {
+	for name, variable := range scope.Variables {
+		if slices.Contains(builtins, name) {
+			vars = append(vars, variable)
+		}
+	}
+
+	for _, child := range scope.Children {
+		vars = shadowingVars(child, builtins, vars)
+	}
+
+	return vars
+}
+
+func checkShadowBuiltins(builtins []string, ana *one.Analyzer) {
+	scopeTree := ana.ParseResult.ScopeTree
+	if scopeTree == nil {
+		return
+	}
+
+	// top-level declarations are left alone, only nested scopes are checked.
+	var vars []*one.Variable
+	for _, scope := range scopeTree.Root.Children {
+		vars = shadowingVars(scope, builtins, vars)
+	}
+
+	sort.Slice(vars, func(i, j int) bool {
+		return vars[i].DeclNode.StartByte() < vars[j].DeclNode.StartByte()
+	})
+
+	for _, variable := range vars {
+		ana.Report(&one.Issue{
+			Message: fmt.Sprintf("'%s' shadows a built-in global", variable.Name),
+			Range:   variable.DeclNode.Range(),
+		})
+	}
+}
+
+// NoShadowBuiltins flags variables and parameters in nested scopes that
+// shadow a built-in global, like `Array` or `Object`.
+// When `opts` is nil, the default options are used.
+func NoShadowBuiltins(opts *NoShadowBuiltinsOptions) one.Rule {
+	builtins := defaultBuiltins
+	if opts != nil {
+		builtins = append(slices.Clone(defaultBuiltins), opts.Builtins...)
+	}
+
+	var exit one.VisitFn = func(r one.Rule, ana *one.Analyzer, root *sitter.Node) {
+		checkShadowBuiltins(builtins, ana)
+	}
+
+	return one.CreateRule("js-no-shadow-builtins", one.FileNodeType, one.LangJs, nil, &exit)
+}
@@ -0,0 +1,90 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoSyncInAsyncOptions struct {
+	// Functions is a list of blocking function names to flag,
+	// in addition to the synchronous `fs` and `child_process` APIs.
+	Functions []string
+	// ReplaceDefaults only flags the names in `Functions`, and not
+	// the synchronous `fs` and `child_process` APIs.
+	// e.g: to allow `existsSync`, pass every other default name in `Functions`.
+	ReplaceDefaults bool
+}
+
+var defaultSyncFunctions = []string{
+	"accessSync", "appendFileSync", "copyFileSync", "existsSync", "lstatSync",
+	"mkdirSync", "readdirSync", "readFileSync", "renameSync", "rmdirSync",
+	"rmSync", "statSync", "unlinkSync", "writeFileSync",
+	"execSync", "execFileSync", "spawnSync",
+}
+
+func isAsyncFunction(fn *sitter.Node) bool {
+	for i := 0; i < int(fn.ChildCount()); i++ {
+		child := fn.Child(i)
+		if !child.IsNamed() && child.Type() == "async" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// calleeName returns the name of the function being called,
+// i.e: `readFileSync` for both `readFileSync()` and `fs.readFileSync()`.
+func calleeName(call *sitter.Node, source []byte) string {
+	callee := call.ChildByFieldName("function")
+	if callee == nil {
+		return ""
+	}
+
+	switch callee.Type() {
+	case "identifier":
+		return callee.Content(source)
+	case "member_expression":
+		return callee.ChildByFieldName("property").Content(source)
+	default:
+		return ""
+	}
+}
+
+func checkSyncInAsync(banned []string, ana *one.Analyzer, node *sitter.Node) {
+	name := calleeName(node, ana.ParseResult.Source)
+	if !slices.Contains(banned, name) {
+		return
+	}
+
+	fn := nearestFunction(node)
+	if fn == nil || !isAsyncFunction(fn) {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("'%s' blocks the event loop inside an async function. Use the async variant instead.", name),
+		Range:   node.Range(),
+	})
+}
+
+// NoSyncInAsync flags calls to blocking functions like `fs.readFileSync`
+// inside async functions.
+// When `opts` is nil, the default options are used.
+func NoSyncInAsync(opts *NoSyncInAsyncOptions) one.Rule {
+	banned := defaultSyncFunctions
+	if opts != nil && opts.ReplaceDefaults {
+		banned = opts.Functions
+	} else if opts != nil {
+		banned = append(slices.Clone(defaultSyncFunctions), opts.Functions...)
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkSyncInAsync(banned, ana, node)
+	}
+
+	return one.CreateRule("js-no-sync-in-async", "call_expression", one.LangJs, &entry, nil)
+}
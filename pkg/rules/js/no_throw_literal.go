@@ -0,0 +1,59 @@
+package js_rules
+
+import (
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoThrowLiteralOptions struct {
+	// AllowObjects allows throwing object literals, like `throw { code: 404 }`,
+	// for codebases that intentionally throw plain objects.
+	AllowObjects bool
+}
+
+var literalNodeTypes = []string{
+	"string", "template_string", "number", "true", "false",
+	"null", "undefined", "regex", "array",
+}
+
+func checkThrowLiteral(opts *NoThrowLiteralOptions, ana *one.Analyzer, node *sitter.Node) {
+	thrown := node.NamedChild(0)
+	for thrown != nil && thrown.Type() == "parenthesized_expression" {
+		thrown = thrown.NamedChild(0)
+	}
+
+	if thrown == nil {
+		return
+	}
+
+	isLiteral := slices.Contains(literalNodeTypes, thrown.Type())
+	if thrown.Type() == "object" {
+		isLiteral = !opts.AllowObjects
+	}
+
+	if !isLiteral {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: "Expected an Error object to be thrown. Throwing a literal loses the stack trace.",
+		Range:   thrown.Range(),
+	})
+}
+
+// NoThrowLiteral flags `throw` statements that throw a literal value
+// instead of an Error, like `throw "oops"`.
+// When `opts` is nil, the default options are used.
+func NoThrowLiteral(opts *NoThrowLiteralOptions) one.Rule {
+	if opts == nil {
+		opts = &NoThrowLiteralOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkThrowLiteral(opts, ana, node)
+	}
+
+	return one.CreateRule("js-no-throw-literal", "throw_statement", one.LangJs, &entry, nil)
+}
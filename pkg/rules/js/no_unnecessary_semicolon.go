@@ -0,0 +1,42 @@
+package js_rules
+
+import (
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// statementListTypes are the nodes whose children are a list of statements,
+// where an empty statement is never required.
+// Elsewhere (e.g: `for (;;);`, `while (x);`) the `;` is the body of a statement.
+var statementListTypes = []string{
+	"program",
+	"statement_block",
+	"switch_case",
+	"switch_default",
+}
+
+func checkUnnecessarySemicolon(ana *one.Analyzer, node *sitter.Node) {
+	parent := node.Parent()
+	if parent == nil || !slices.Contains(statementListTypes, parent.Type()) {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: "Unnecessary semicolon",
+		Range:   node.Range(),
+		Node:    node,
+		Fix:     &one.Fix{Range: node.Range()},
+	})
+}
+
+// NoUnnecessarySemicolon flags stray semicolons (empty statements)
+// that serve no purpose, e.g: `foo();;`.
+func NoUnnecessarySemicolon() one.Rule {
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkUnnecessarySemicolon(ana, node)
+	}
+
+	return one.CreateRule("js-no-unnecessary-semicolon", "empty_statement", one.LangJs, &entry, nil)
+}
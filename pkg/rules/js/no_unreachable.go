@@ -0,0 +1,65 @@
+package js_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+func isTerminatingStatement(node *sitter.Node) bool {
+	switch node.Type() {
+	case "return_statement", "throw_statement", "break_statement", "continue_statement":
+		return true
+	default:
+		return false
+	}
+}
+
+// isHoisted returns true for statements that are still "reachable"
+// after a return/throw, because they are hoisted to the top of their scope.
+func isHoisted(node *sitter.Node) bool {
+	typ := node.Type()
+	return typ == "function_declaration" || typ == "generator_function_declaration"
+}
+
+func checkUnreachable(r one.Rule, ana *one.Analyzer, block *sitter.Node) {
+	terminated := false
+	for i := 0; i < int(block.ChildCount()); i++ {
+		stmt := block.Child(i)
+		if !stmt.IsNamed() || stmt.Type() == "comment" {
+			continue
+		}
+
+		// case <value>: ...
+		if block.FieldNameForChild(i) == "value" {
+			continue
+		}
+
+		if terminated {
+			if isHoisted(stmt) || stmt.Type() == "empty_statement" {
+				continue
+			}
+
+			ana.Report(&one.Issue{
+				Message: "Unreachable code",
+				Range:   stmt.Range(),
+			})
+			return
+		}
+
+		terminated = isTerminatingStatement(stmt)
+	}
+}
+
+// NoUnreachable flags statements that appear after a `return`, `throw`,
+// `break`, or `continue` in the same block.
+// Only the first unreachable statement in a block is reported.
+func NoUnreachable() one.Rule {
+	var entry one.VisitFn = checkUnreachable
+	return one.CreateMultiNodeRule(
+		"js-no-unreachable",
+		[]string{"program", "statement_block", "switch_case", "switch_default"},
+		one.LangJs,
+		&entry,
+		nil,
+	)
+}
@@ -0,0 +1,153 @@
+package js_rules
+
+import (
+	"fmt"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoUnusedParamsOptions struct {
+	// IgnoreUnderscored skips parameters whose name starts with `_`,
+	// a common way to mark a parameter as intentionally unused.
+	IgnoreUnderscored bool
+}
+
+// functionParams returns the parameters of `fn` in the order they are declared.
+func functionParams(fn *sitter.Node) []*sitter.Node {
+	// x => ...
+	if param := fn.ChildByFieldName("parameter"); param != nil {
+		return []*sitter.Node{param}
+	}
+
+	params := fn.ChildByFieldName("parameters")
+	if params == nil {
+		return nil
+	}
+
+	var nodes []*sitter.Node
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		// comments are named nodes too, but they aren't parameters.
+		if param := params.NamedChild(i); param.Type() != "comment" {
+			nodes = append(nodes, param)
+		}
+	}
+
+	return nodes
+}
+
+// isParameterProperty returns true for TypeScript constructor parameters
+// that also declare a class field, like `constructor(private x)`.
+// These are used through `this`, which the scope tree doesn't track.
+func isParameterProperty(param *sitter.Node) bool {
+	return one.FindMatchingChild(param, func(child *sitter.Node) bool {
+		return child.Type() == "accessibility_modifier" || child.Type() == "readonly"
+	}) != nil
+}
+
+func isSetter(fn *sitter.Node) bool {
+	return fn.Type() == "method_definition" && one.FirstChildOfType(fn, "set") != nil
+}
+
+// paramRemoval returns a fix that removes the i-th parameter in `params`,
+// along with the comma that separates it from the previous one.
+func paramRemoval(params []*sitter.Node, i int) *one.Fix {
+	param := params[i]
+	start, startPoint := param.StartByte(), param.StartPoint()
+	if i > 0 {
+		start, startPoint = params[i-1].EndByte(), params[i-1].EndPoint()
+	}
+
+	return &one.Fix{
+		Range: sitter.Range{
+			StartPoint: startPoint,
+			EndPoint:   param.EndPoint(),
+			StartByte:  start,
+			EndByte:    param.EndByte(),
+		},
+	}
+}
+
+func checkUnusedParams(opts *NoUnusedParamsOptions, ana *one.Analyzer, fn *sitter.Node) {
+	scopeTree := ana.ParseResult.ScopeTree
+	if scopeTree == nil {
+		return
+	}
+
+	scope := scopeTree.ScopeOfNode[fn]
+	if scope == nil {
+		return
+	}
+
+	// a destructured parameter declares several variables,
+	// and is only unused if none of them are.
+	used := map[*sitter.Node]bool{}
+	declared := map[*sitter.Node]bool{}
+	for _, variable := range scope.Variables {
+		if variable.Kind != one.VarKindParameter {
+			continue
+		}
+
+		declared[variable.DeclNode] = true
+		if len(variable.Refs) > 0 {
+			used[variable.DeclNode] = true
+		}
+	}
+
+	params := functionParams(fn)
+	lastUsed := -1
+	for i, param := range params {
+		if used[param] || isParameterProperty(param) {
+			lastUsed = i
+		}
+	}
+
+	source := ana.ParseResult.Source
+	for i, param := range params {
+		if !declared[param] || used[param] || isParameterProperty(param) {
+			continue
+		}
+
+		name := param.Content(source)
+		if pattern := param.ChildByFieldName("pattern"); pattern != nil {
+			name = pattern.Content(source)
+		}
+
+		if opts.IgnoreUnderscored && strings.HasPrefix(name, "_") {
+			continue
+		}
+
+		issue := &one.Issue{
+			Message: fmt.Sprintf("Parameter '%s' is never used", name),
+			Range:   param.Range(),
+			Node:    param,
+		}
+
+		// parameters before a used one can't be removed without shifting the arguments.
+		if i > lastUsed && !isSetter(fn) {
+			issue.Fix = paramRemoval(params, i)
+			// `x => 1` needs an empty pair of parentheses once `x` is removed.
+			if fn.ChildByFieldName("parameter") != nil {
+				issue.Fix = &one.Fix{Range: param.Range(), Replacement: "()"}
+			}
+		}
+
+		ana.Report(issue)
+	}
+}
+
+// NoUnusedParams flags function parameters that are never used in the function.
+// Unused parameters after the last used one can be removed with a fix.
+// When `opts` is nil, the default options are used.
+func NoUnusedParams(opts *NoUnusedParamsOptions) one.Rule {
+	if opts == nil {
+		opts = &NoUnusedParamsOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkUnusedParams(opts, ana, node)
+	}
+
+	return one.CreateMultiNodeRule("js-no-unused-params", functionNodeTypes, one.LangJs, &entry, nil)
+}
@@ -0,0 +1,284 @@
+package js_rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoUnusedVarsOptions struct {
+	// IgnoreVariables skips variables declared with `let`, `const` or `var`.
+	IgnoreVariables bool
+	// IgnoreFunctions skips function declarations.
+	IgnoreFunctions bool
+	// IgnoreParams skips function parameters.
+	IgnoreParams bool
+	// IgnoreImports skips imported names.
+	IgnoreImports bool
+	// IgnoreUnderscored skips bindings whose name starts with `_`,
+	// a common way to mark them as intentionally unused.
+	// Enabled by default.
+	IgnoreUnderscored bool
+}
+
+// kindIgnored returns true if bindings of `kind` shouldn't be checked.
+func (opts *NoUnusedVarsOptions) kindIgnored(kind one.VarKind) bool {
+	switch kind {
+	case one.VarKindVariable:
+		return opts.IgnoreVariables
+	case one.VarKindFunction:
+		return opts.IgnoreFunctions
+	case one.VarKindParameter:
+		return opts.IgnoreParams
+	case one.VarKindImport:
+		return opts.IgnoreImports
+	default:
+		return true
+	}
+}
+
+// bindingIdentifier returns the identifier that declares `variable`,
+// like `a` in `const { a } = obj`, or `f` in `function f() {}`.
+func bindingIdentifier(variable *one.Variable, source []byte) *sitter.Node {
+	decl := variable.DeclNode
+	var binding *sitter.Node
+	switch decl.Type() {
+	case "variable_declarator", "function_declaration":
+		binding = decl.ChildByFieldName("name")
+	case "required_parameter", "optional_parameter":
+		binding = decl.ChildByFieldName("pattern")
+	case "import_specifier":
+		// alias (<imported> as <local>)
+		binding = decl.Child(2)
+		if binding == nil {
+			binding = decl.ChildByFieldName("name")
+		}
+	default:
+		binding = decl
+	}
+
+	if binding == nil {
+		return nil
+	}
+
+	return findIdentifier(binding, variable.Name, source)
+}
+
+// findIdentifier returns the first identifier called `name` in the tree rooted at `node`.
+func findIdentifier(node *sitter.Node, name string, source []byte) *sitter.Node {
+	switch node.Type() {
+	case "identifier", "shorthand_property_identifier_pattern":
+		if node.Content(source) == name {
+			return node
+		}
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if found := findIdentifier(node.NamedChild(i), name, source); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+// isRead returns true if `variable` is read anywhere outside of its own declaration.
+// A function that only calls itself is unused, and so is a variable that is only ever written to.
+func isRead(variable *one.Variable) bool {
+	return slices.ContainsFunc(variable.Refs, func(ref *one.Reference) bool {
+		if ref.IsWriteRef {
+			return false
+		}
+
+		if variable.Kind == one.VarKindFunction && isWithin(ref.Node, variable.DeclNode) {
+			return false
+		}
+
+		return true
+	})
+}
+
+// isExported returns true if `decl`, the declaration node of a variable, is exported,
+// like `export const x = 1` or `export function f() {}`.
+// Bindings declared inside an exported function are still checked.
+func isExported(decl *sitter.Node) bool {
+	declaration := decl
+	if decl.Type() == "variable_declarator" {
+		// `const x = 1` is a `lexical_declaration`, and `var x = 1` a `variable_declaration`.
+		declaration = decl.Parent()
+	}
+
+	if declaration == nil {
+		return false
+	}
+
+	switch declaration.Type() {
+	case "lexical_declaration", "variable_declaration", "function_declaration", "generator_function_declaration":
+		parent := declaration.Parent()
+		return parent != nil && parent.Type() == "export_statement"
+	default:
+		return false
+	}
+}
+
+// hasRestSibling returns true for bindings like `a` in `const { a, ...rest } = obj`,
+// which are often declared only to leave them out of `rest`.
+func hasRestSibling(binding *sitter.Node) bool {
+	pattern := binding.Parent()
+	if pattern != nil && pattern.Type() == "pair_pattern" {
+		pattern = pattern.Parent()
+	}
+
+	return pattern != nil && pattern.Type() == "object_pattern" && one.FirstChildOfType(pattern, "rest_pattern") != nil
+}
+
+// usedLater returns true if a parameter after `param` in the same function is used.
+// Unused parameters before a used one can't be removed without shifting the arguments.
+func usedLater(param *sitter.Node, usedDecls map[*sitter.Node]bool) bool {
+	params := param.Parent()
+	if params == nil || params.Type() != "formal_parameters" {
+		return false
+	}
+
+	for next := param.NextNamedSibling(); next != nil; next = next.NextNamedSibling() {
+		if usedDecls[next] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// typeNames returns the names of all types referenced in the file.
+// The scope tree doesn't track references in type annotations,
+// so imports that are only used as types would look unused otherwise.
+func typeNames(file *one.ParseResult) map[string]bool {
+	names := map[string]bool{}
+	for _, node := range file.FindAll("type_identifier") {
+		names[node.Content(file.Source)] = true
+	}
+
+	return names
+}
+
+// allVariables returns the variables declared in `scope` and all of its children.
+func allVariables(scope *one.Scope, variables []*one.Variable) []*one.Variable {
+	for _, variable := range scope.Variables {
+		variables = append(variables, variable)
+	}
+
+	for _, child := range scope.Children {
+		variables = allVariables(child, variables)
+	}
+
+	return variables
+}
+
+func unusedVarMessage(variable *one.Variable) string {
+	switch variable.Kind {
+	case one.VarKindFunction:
+		return fmt.Sprintf("Function '%s' is declared but never used", variable.Name)
+	case one.VarKindParameter:
+		return fmt.Sprintf("Parameter '%s' is never used", variable.Name)
+	case one.VarKindImport:
+		return fmt.Sprintf("'%s' is imported but never used", variable.Name)
+	}
+
+	if len(variable.Refs) > 0 {
+		return fmt.Sprintf("'%s' is assigned a value but never read", variable.Name)
+	}
+
+	return fmt.Sprintf("'%s' is declared but never used", variable.Name)
+}
+
+func checkUnusedVars(opts *NoUnusedVarsOptions, ana *one.Analyzer) {
+	scopeTree := ana.ParseResult.ScopeTree
+	if scopeTree == nil || scopeTree.Root == nil {
+		return
+	}
+
+	source := ana.ParseResult.Source
+	variables := allVariables(scopeTree.Root, nil)
+	types := typeNames(ana.ParseResult)
+
+	// a destructured declaration declares several variables,
+	// which share a declaration node.
+	usedDecls := map[*sitter.Node]bool{}
+	for _, variable := range variables {
+		if isRead(variable) {
+			usedDecls[variable.DeclNode] = true
+		}
+	}
+
+	var unused []*sitter.Node
+	messages := map[*sitter.Node]string{}
+	for _, variable := range variables {
+		if opts.kindIgnored(variable.Kind) || isRead(variable) {
+			continue
+		}
+
+		if variable.Kind == one.VarKindImport && types[variable.Name] {
+			continue
+		}
+
+		if opts.IgnoreUnderscored && strings.HasPrefix(variable.Name, "_") {
+			continue
+		}
+
+		if isExported(variable.DeclNode) {
+			continue
+		}
+
+		binding := bindingIdentifier(variable, source)
+		if binding == nil || hasRestSibling(binding) {
+			continue
+		}
+
+		if variable.Kind == one.VarKindParameter {
+			if usedLater(variable.DeclNode, usedDecls) || isParameterProperty(variable.DeclNode) {
+				continue
+			}
+
+			// setters must declare a parameter, even if they don't use it.
+			params := variable.DeclNode.Parent()
+			if params != nil && params.Parent() != nil && isSetter(params.Parent()) {
+				continue
+			}
+		}
+
+		unused = append(unused, binding)
+		messages[binding] = unusedVarMessage(variable)
+	}
+
+	slices.SortFunc(unused, func(a, b *sitter.Node) int {
+		return int(a.StartByte()) - int(b.StartByte())
+	})
+
+	for _, binding := range unused {
+		ana.Report(&one.Issue{
+			Message: messages[binding],
+			Range:   binding.Range(),
+			Node:    binding,
+		})
+	}
+}
+
+// NoUnusedVars flags variables, functions, parameters and imports that are never read,
+// using the scope tree of the file. Exported bindings are never reported.
+// It isn't enabled by default, since UnusedImport, NoUnusedParams and NoDeadStore
+// already report most of the same bindings.
+// When `opts` is nil, the default options are used.
+func NoUnusedVars(opts *NoUnusedVarsOptions) one.Rule {
+	if opts == nil {
+		opts = &NoUnusedVarsOptions{IgnoreUnderscored: true}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkUnusedVars(opts, ana)
+	}
+
+	return one.CreateRule("js-no-unused-vars", one.FileNodeType, one.LangJs, &entry, nil)
+}
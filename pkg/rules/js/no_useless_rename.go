@@ -0,0 +1,70 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// renamedNames returns the original name in a rename, the name it's bound to,
+// and the text that `node` can be replaced with if both are the same.
+func renamedNames(node *sitter.Node, source []byte) (original, renamed *sitter.Node, replacement string) {
+	switch node.Type() {
+	case "import_specifier", "export_specifier":
+		// import { <name> as <alias> } from "..."
+		original, renamed = node.ChildByFieldName("name"), node.ChildByFieldName("alias")
+		if original == nil || renamed == nil || original.Type() != "identifier" {
+			return nil, nil, ""
+		}
+
+		return original, renamed, original.Content(source)
+
+	case "pair_pattern":
+		// const { <key>: <value> } = ...
+		original, renamed = node.ChildByFieldName("key"), node.ChildByFieldName("value")
+		if original == nil || renamed == nil || original.Type() != "property_identifier" {
+			return nil, nil, ""
+		}
+
+		// const { <key>: <value> = <default> } = ...
+		binding := renamed
+		if renamed.Type() == "assignment_pattern" {
+			binding = renamed.ChildByFieldName("left")
+		}
+
+		if binding == nil || binding.Type() != "identifier" {
+			return nil, nil, ""
+		}
+
+		return original, binding, renamed.Content(source)
+	}
+
+	return nil, nil, ""
+}
+
+func checkUselessRename(ana *one.Analyzer, node *sitter.Node) {
+	source := ana.ParseResult.Source
+	original, renamed, replacement := renamedNames(node, source)
+	if original == nil || original.Content(source) != renamed.Content(source) {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("'%s' is renamed to itself", original.Content(source)),
+		Range:   node.Range(),
+		Node:    node,
+		Fix:     &one.Fix{Range: node.Range(), Replacement: replacement},
+	})
+}
+
+// NoUselessRename flags imports, exports and destructuring patterns that
+// rename a binding to the same name, like `import { foo as foo } from "foo"`.
+func NoUselessRename() one.Rule {
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkUselessRename(ana, node)
+	}
+
+	nodeTypes := []string{"import_specifier", "export_specifier", "pair_pattern"}
+	return one.CreateMultiNodeRule("js-no-useless-rename", nodeTypes, one.LangJs, &entry, nil)
+}
@@ -0,0 +1,86 @@
+package js_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type PreferEarlyReturnOptions struct {
+	// MinStatements is the number of statements an `if` must wrap to be flagged.
+	// Defaults to 3 when 0.
+	MinStatements int
+}
+
+const defaultMinWrappedStatements = 3
+
+// statementsWithoutComments is like `statementsOf`, but leaves out comments.
+func statementsWithoutComments(block *sitter.Node) []*sitter.Node {
+	var statements []*sitter.Node
+	for _, stmt := range statementsOf(block) {
+		if stmt.Type() != "comment" {
+			statements = append(statements, stmt)
+		}
+	}
+
+	return statements
+}
+
+func checkPreferEarlyReturn(opts *PreferEarlyReturnOptions, ana *one.Analyzer, node *sitter.Node) {
+	body := node.ChildByFieldName("body")
+	if body == nil || body.Type() != "statement_block" {
+		return
+	}
+
+	statements := statementsWithoutComments(body)
+	if len(statements) != 1 || statements[0].Type() != "if_statement" {
+		return
+	}
+
+	ifStmt := statements[0]
+	if ifStmt.ChildByFieldName("alternative") != nil {
+		return
+	}
+
+	consequence := ifStmt.ChildByFieldName("consequence")
+	if consequence == nil || consequence.Type() != "statement_block" {
+		return
+	}
+
+	minStatements := opts.MinStatements
+	if minStatements == 0 {
+		minStatements = defaultMinWrappedStatements
+	}
+
+	if len(statementsWithoutComments(consequence)) < minStatements {
+		return
+	}
+
+	// only the `if (...)` part is highlighted, not the whole body.
+	issueRange := ifStmt.Range()
+	if condition := ifStmt.ChildByFieldName("condition"); condition != nil {
+		issueRange.EndByte = condition.EndByte()
+		issueRange.EndPoint = condition.EndPoint()
+	}
+
+	ana.Report(&one.Issue{
+		Message:  "This 'if' wraps the entire function body. Consider inverting it into an early return",
+		Severity: one.SeverityInfo,
+		Range:    issueRange,
+		Node:     ifStmt,
+	})
+}
+
+// PreferEarlyReturn suggests turning an `if` that wraps the whole body of a
+// function into a guard clause, like `if (!cond) return`, to reduce nesting.
+// When `opts` is nil, the default options are used.
+func PreferEarlyReturn(opts *PreferEarlyReturnOptions) one.Rule {
+	if opts == nil {
+		opts = &PreferEarlyReturnOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkPreferEarlyReturn(opts, ana, node)
+	}
+
+	return one.CreateMultiNodeRule("js-prefer-early-return", functionNodeTypes, one.LangJs, &entry, nil)
+}
@@ -0,0 +1,173 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// loopIndex returns the identifier of the index variable of a loop that starts with `let i = 0`.
+func loopIndex(initializer *sitter.Node, source []byte) *sitter.Node {
+	if initializer == nil || initializer.Type() != "lexical_declaration" || initializer.NamedChildCount() != 1 {
+		return nil
+	}
+
+	declarator := initializer.NamedChild(0)
+	name := declarator.ChildByFieldName("name")
+	value := declarator.ChildByFieldName("value")
+	if name == nil || name.Type() != "identifier" || value == nil || value.Content(source) != "0" {
+		return nil
+	}
+
+	return name
+}
+
+// loopedArray returns the array in a loop condition like `i < arr.length`.
+func loopedArray(condition *sitter.Node, index string, source []byte) *sitter.Node {
+	if condition == nil || condition.Type() != "expression_statement" || condition.NamedChildCount() != 1 {
+		return nil
+	}
+
+	comparison := condition.NamedChild(0)
+	if comparison.Type() != "binary_expression" || operatorOf(comparison) != "<" {
+		return nil
+	}
+
+	left := comparison.ChildByFieldName("left")
+	right := comparison.ChildByFieldName("right")
+	if left == nil || left.Content(source) != index || right == nil || right.Type() != "member_expression" {
+		return nil
+	}
+
+	property := right.ChildByFieldName("property")
+	array := right.ChildByFieldName("object")
+	if property == nil || property.Content(source) != "length" || array == nil {
+		return nil
+	}
+
+	// arrays computed in the condition (like `getItems().length`) may change every iteration.
+	switch array.Type() {
+	case "identifier", "member_expression", "this":
+		return array
+	default:
+		return nil
+	}
+}
+
+// incrementsByOne returns true for loop increments like `i++`, `++i` and `i += 1`.
+func incrementsByOne(increment *sitter.Node, index string, source []byte) bool {
+	if increment == nil {
+		return false
+	}
+
+	switch increment.Type() {
+	case "update_expression":
+		text := increment.Content(source)
+		return text == index+"++" || text == "++"+index
+	case "augmented_assignment_expression":
+		left := increment.ChildByFieldName("left")
+		right := increment.ChildByFieldName("right")
+		return left != nil && left.Content(source) == index &&
+			right != nil && right.Content(source) == "1" &&
+			operatorOf(increment) == "+="
+	default:
+		return false
+	}
+}
+
+// isElementRead returns true if `ref` is only used to read an element of `array`, as in `array[i]`.
+func isElementRead(ref *sitter.Node, array string, source []byte) bool {
+	subscript := ref.Parent()
+	if subscript == nil || subscript.Type() != "subscript_expression" || subscript.ChildByFieldName("index") != ref {
+		return false
+	}
+
+	object := subscript.ChildByFieldName("object")
+	if object == nil || object.Content(source) != array {
+		return false
+	}
+
+	// writing to `array[i]` can't be done with `for-of`.
+	parent := subscript.Parent()
+	switch parent.Type() {
+	case "assignment_expression", "augmented_assignment_expression":
+		return parent.ChildByFieldName("left") != subscript
+	case "update_expression":
+		return false
+	default:
+		return true
+	}
+}
+
+func isWithin(node, ancestor *sitter.Node) bool {
+	return ancestor != nil && node.StartByte() >= ancestor.StartByte() && node.EndByte() <= ancestor.EndByte()
+}
+
+func checkPreferForOf(ana *one.Analyzer, node *sitter.Node) {
+	scopeTree := ana.ParseResult.ScopeTree
+	if scopeTree == nil {
+		return
+	}
+
+	source := ana.ParseResult.Source
+	indexNode := loopIndex(node.ChildByFieldName("initializer"), source)
+	if indexNode == nil {
+		return
+	}
+
+	index := indexNode.Content(source)
+	condition := node.ChildByFieldName("condition")
+	increment := node.ChildByFieldName("increment")
+	array := loopedArray(condition, index, source)
+	if array == nil || !incrementsByOne(increment, index, source) {
+		return
+	}
+
+	scope := scopeTree.GetScope(node)
+	if scope == nil {
+		return
+	}
+
+	variable := scope.Lookup(index)
+	if variable == nil || variable.DeclNode == nil || !isWithin(variable.DeclNode, node) {
+		return
+	}
+
+	arrayName := array.Content(source)
+	uses := 0
+	for _, ref := range variable.Refs {
+		if isWithin(ref.Node, condition) || isWithin(ref.Node, increment) {
+			continue
+		}
+
+		if ref.IsWriteRef || !isElementRead(ref.Node, arrayName, source) {
+			return
+		}
+
+		uses++
+	}
+
+	if uses == 0 {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf(
+			"'%s' is only used to index '%s'. Use a for-of loop instead: `for (const item of %s)`",
+			index, arrayName, arrayName,
+		),
+		Range: node.Range(),
+		Node:  node,
+	})
+}
+
+// PreferForOf flags index-based `for` loops where the index is only used
+// to read elements of the array being looped over, which are clearer as `for-of` loops.
+func PreferForOf() one.Rule {
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkPreferForOf(ana, node)
+	}
+
+	return one.CreateRule("js-prefer-for-of", "for_statement", one.LangJs, &entry, nil)
+}
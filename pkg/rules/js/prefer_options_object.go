@@ -0,0 +1,77 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type PreferOptionsObjectOptions struct {
+	// Max is the maximum number of positional parameters a function can have.
+	// Defaults to 3 when 0.
+	Max int
+}
+
+const defaultMaxPositionalParams = 3
+
+// positionalParams returns the parameters in a parameter list that are passed by position.
+// Destructured objects like `{ a, b }` already work like an options object, and rest
+// parameters take any number of arguments, so neither is counted. Nor is TypeScript's `this` parameter.
+func positionalParams(params *sitter.Node) []*sitter.Node {
+	var positional []*sitter.Node
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		param := params.NamedChild(i)
+		if param.Type() == "comment" {
+			continue
+		}
+
+		if pattern := param.ChildByFieldName("pattern"); pattern != nil {
+			switch pattern.Type() {
+			case "this", "object_pattern", "rest_pattern":
+				continue
+			}
+		}
+
+		positional = append(positional, param)
+	}
+
+	return positional
+}
+
+func checkPreferOptionsObject(opts *PreferOptionsObjectOptions, ana *one.Analyzer, params *sitter.Node) {
+	max := opts.Max
+	if max == 0 {
+		max = defaultMaxPositionalParams
+	}
+
+	count := len(positionalParams(params))
+	if count <= max {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf(
+			"Function has %d positional parameters, which exceeds the maximum of %d. "+
+				"Consider accepting a single options object, like `{ a, b, c }`, instead",
+			count, max,
+		),
+		Range: params.Range(),
+		Node:  params,
+	})
+}
+
+// PreferOptionsObject flags functions with many positional parameters, which are easy
+// to pass in the wrong order, and suggests a destructured options object instead.
+// When `opts` is nil, the default options are used.
+func PreferOptionsObject(opts *PreferOptionsObjectOptions) one.Rule {
+	if opts == nil {
+		opts = &PreferOptionsObjectOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkPreferOptionsObject(opts, ana, node)
+	}
+
+	return one.CreateRule("js-prefer-options-object", "formal_parameters", one.LangJs, &entry, nil)
+}
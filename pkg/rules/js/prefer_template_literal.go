@@ -0,0 +1,154 @@
+package js_rules
+
+import (
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+func isConcatenation(node *sitter.Node) bool {
+	if node == nil || node.Type() != "binary_expression" {
+		return false
+	}
+
+	operator := node.ChildByFieldName("operator")
+	return operator != nil && operator.Type() == "+"
+}
+
+func unwrapParens(node *sitter.Node) *sitter.Node {
+	for node.Type() == "parenthesized_expression" && node.NamedChildCount() == 1 {
+		node = node.NamedChild(0)
+	}
+
+	return node
+}
+
+// concatOperands flattens a left-associative chain like `a + b + c`
+// into its operands. `hasComments` is set if any comment sits between operands.
+func concatOperands(node *sitter.Node, operands []*sitter.Node, hasComments *bool) []*sitter.Node {
+	if !isConcatenation(node) {
+		return append(operands, node)
+	}
+
+	for i := 0; i < int(node.NamedChildCount()); i++ {
+		if node.NamedChild(i).Type() == "comment" {
+			*hasComments = true
+		}
+	}
+
+	operands = concatOperands(node.ChildByFieldName("left"), operands, hasComments)
+	return append(operands, node.ChildByFieldName("right"))
+}
+
+// templateContent converts the body of a string literal into
+// the body of an equivalent template literal.
+func templateContent(body string) string {
+	var sb strings.Builder
+	for i := 0; i < len(body); i++ {
+		switch c := body[i]; {
+		case c == '\\' && i+1 < len(body):
+			sb.WriteByte(c)
+			sb.WriteByte(body[i+1])
+			i++
+		case c == '`':
+			sb.WriteString("\\`")
+		case c == '$' && i+1 < len(body) && body[i+1] == '{':
+			sb.WriteString("\\$")
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return sb.String()
+}
+
+// toTemplateLiteral rewrites the operands of a concatenation as a template literal.
+// `firstString` is the index of the first string literal operand, operands before it
+// are added together before any concatenation happens, so they're kept in one substitution.
+func toTemplateLiteral(operands []*sitter.Node, firstString int, source []byte) string {
+	var sb strings.Builder
+	sb.WriteByte('`')
+
+	if firstString > 1 {
+		leading := source[operands[0].StartByte():operands[firstString-1].EndByte()]
+		sb.WriteString("${" + string(leading) + "}")
+		operands = operands[firstString:]
+	}
+
+	for _, operand := range operands {
+		text := operand.Content(source)
+		switch operand.Type() {
+		case "string":
+			sb.WriteString(templateContent(text[1 : len(text)-1]))
+		case "template_string":
+			sb.WriteString(text[1 : len(text)-1])
+		default:
+			sb.WriteString("${" + unwrapParens(operand).Content(source) + "}")
+		}
+	}
+
+	sb.WriteByte('`')
+	return sb.String()
+}
+
+func checkTemplateLiteral(ana *one.Analyzer, node *sitter.Node) {
+	if !isConcatenation(node) {
+		return
+	}
+
+	// only the outermost concatenation is reported.
+	parent := node.Parent()
+	for parent != nil && parent.Type() == "parenthesized_expression" {
+		parent = parent.Parent()
+	}
+
+	if isConcatenation(parent) {
+		return
+	}
+
+	hasComments := false
+	operands := concatOperands(node, nil, &hasComments)
+
+	firstString, hasExpression := -1, false
+	for i, operand := range operands {
+		switch operand.Type() {
+		case "string":
+			if firstString == -1 {
+				firstString = i
+			}
+		case "template_string":
+		default:
+			hasExpression = true
+		}
+	}
+
+	// `"a" + "b"` is usually split to keep lines short, and `1 + x` isn't a string.
+	if firstString == -1 || !hasExpression {
+		return
+	}
+
+	issue := &one.Issue{
+		Message: "Unexpected string concatenation. Use a template literal instead.",
+		Range:   node.Range(),
+	}
+
+	if !hasComments {
+		issue.Fix = &one.Fix{
+			Range:       node.Range(),
+			Replacement: toTemplateLiteral(operands, firstString, ana.ParseResult.Source),
+		}
+	}
+
+	ana.Report(issue)
+}
+
+// PreferTemplateLiteral flags string concatenations with `+`
+// that could be written as a template literal.
+func PreferTemplateLiteral() one.Rule {
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkTemplateLiteral(ana, node)
+	}
+
+	return one.CreateRule("js-prefer-template-literal", "binary_expression", one.LangJs, &entry, nil)
+}
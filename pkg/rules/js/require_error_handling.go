@@ -0,0 +1,103 @@
+package js_rules
+
+import (
+	"fmt"
+	"path"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type RequireErrorHandlingOptions struct {
+	// PromiseFunctions is a list of patterns for callees that return a promise,
+	// in addition to the defaults. Patterns use `path.Match` syntax, like "*Async".
+	PromiseFunctions []string
+}
+
+// Without type information, there is no way of knowing which calls return a promise.
+// These are the ones that always do.
+var defaultPromiseFunctions = []string{"fetch", "Promise.*"}
+
+func returnsPromise(patterns []string, callee string) bool {
+	return slices.ContainsFunc(patterns, func(pattern string) bool {
+		matched, _ := path.Match(pattern, callee)
+		return matched
+	})
+}
+
+func checkErrorHandling(patterns []string, ana *one.Analyzer, node *sitter.Node) {
+	expr := node.NamedChild(0)
+	if expr == nil || expr.Type() != "call_expression" {
+		return
+	}
+
+	source := ana.ParseResult.Source
+
+	// walk the chain from the outermost call (`.then(...)`)
+	// to the head call (`fetch(...)`).
+	var head *sitter.Node
+	isPromise, handled, hasThen := false, false, false
+	for call := expr; call != nil && call.Type() == "call_expression"; {
+		head = call
+		callee := call.ChildByFieldName("function")
+		if callee == nil {
+			break
+		}
+
+		if returnsPromise(patterns, callee.Content(source)) {
+			isPromise = true
+		}
+
+		if callee.Type() != "member_expression" {
+			break
+		}
+
+		switch callee.ChildByFieldName("property").Content(source) {
+		case "catch":
+			isPromise, handled = true, true
+		case "then":
+			isPromise, hasThen = true, true
+			// `.then(onFulfilled, onRejected)`
+			if args := call.ChildByFieldName("arguments"); args != nil && args.NamedChildCount() >= 2 {
+				handled = true
+			}
+		case "finally":
+			isPromise = true
+		}
+
+		call = callee.ChildByFieldName("object")
+	}
+
+	if !isPromise || handled {
+		return
+	}
+
+	message := "Promise chain has no '.catch()' handler"
+	if !hasThen {
+		callee := head.ChildByFieldName("function").Content(source)
+		message = fmt.Sprintf("Promise returned by '%s' is not handled", callee)
+	}
+
+	ana.Report(&one.Issue{
+		Message: message,
+		Range:   head.Range(),
+	})
+}
+
+// RequireErrorHandling flags promise chains that have no `.catch()` handler,
+// and calls whose promise is discarded without handling rejections.
+// Awaited promises are not checked.
+// When `opts` is nil, the default options are used.
+func RequireErrorHandling(opts *RequireErrorHandlingOptions) one.Rule {
+	patterns := defaultPromiseFunctions
+	if opts != nil {
+		patterns = append(slices.Clone(defaultPromiseFunctions), opts.PromiseFunctions...)
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkErrorHandling(patterns, ana, node)
+	}
+
+	return one.CreateRule("js-require-error-handling", "expression_statement", one.LangJs, &entry, nil)
+}
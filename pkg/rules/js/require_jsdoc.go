@@ -0,0 +1,115 @@
+package js_rules
+
+import (
+	"fmt"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type RequireJsDocOptions struct {
+	// IncludeUnexported also requires documentation for top-level
+	// functions and classes that aren't exported.
+	IncludeUnexported bool
+}
+
+// hasJsDoc returns true if `node` is immediately preceded by a `/** ... */` comment.
+func hasJsDoc(node *sitter.Node, source []byte) bool {
+	comment := node.PrevSibling()
+	if comment == nil || comment.Type() != "comment" {
+		return false
+	}
+
+	adjacent := comment.EndPoint().Row+1 >= node.StartPoint().Row
+	return adjacent && strings.HasPrefix(comment.Content(source), "/**")
+}
+
+// documentedDeclaration returns the kind of a declaration that needs a doc comment
+// ("function" or "class") and its name node, or an empty kind for other nodes.
+func documentedDeclaration(node *sitter.Node) (kind string, name *sitter.Node) {
+	switch node.Type() {
+	case "function_declaration", "generator_function_declaration":
+		return "function", node.ChildByFieldName("name")
+	case "class_declaration", "abstract_class_declaration":
+		return "class", node.ChildByFieldName("name")
+	case "lexical_declaration", "variable_declaration":
+		// const f = () => ...
+		if node.NamedChildCount() != 1 {
+			return "", nil
+		}
+
+		declarator := node.NamedChild(0)
+		value := declarator.ChildByFieldName("value")
+		if value != nil && (value.Type() == "arrow_function" || value.Type() == "function_expression") {
+			return "function", declarator.ChildByFieldName("name")
+		}
+	}
+
+	return "", nil
+}
+
+func checkJsDoc(opts *RequireJsDocOptions, ana *one.Analyzer, node *sitter.Node) {
+	parent := node.Parent()
+	if parent == nil {
+		return
+	}
+
+	// the comment sits before `export`, not the declaration itself.
+	documented := node
+	exported := parent.Type() == "export_statement"
+	if exported {
+		documented = parent
+	} else if !opts.IncludeUnexported || parent.Type() != "program" {
+		return
+	}
+
+	kind, name := documentedDeclaration(node)
+	if kind == "" || name == nil {
+		return
+	}
+
+	source := ana.ParseResult.Source
+	if hasJsDoc(documented, source) {
+		return
+	}
+
+	visibility := "exported"
+	if !exported {
+		visibility = "top-level"
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("Missing JSDoc comment for %s %s '%s'", visibility, kind, name.Content(source)),
+		Range:   node.Range(),
+		Node:    node,
+	})
+}
+
+// RequireJsDoc flags exported functions and classes that aren't
+// preceded by a JSDoc (`/** ... */`) comment.
+// When `opts` is nil, the default options are used.
+func RequireJsDoc(opts *RequireJsDocOptions) one.Rule {
+	if opts == nil {
+		opts = &RequireJsDocOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkJsDoc(opts, ana, node)
+	}
+
+	return one.CreateMultiNodeRule(
+		"js-require-jsdoc",
+		[]string{
+			"function_declaration",
+			"generator_function_declaration",
+			"class_declaration",
+			"abstract_class_declaration",
+			"lexical_declaration",
+			"variable_declaration",
+		},
+		one.LangJs,
+		&entry,
+		nil,
+	)
+}
@@ -0,0 +1,97 @@
+package js_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type RequireStrictEqualityOptions struct {
+	// AllowNullComparison allows `x == null` and `x != null`,
+	// which are a common way of checking for both `null` and `undefined`.
+	AllowNullComparison bool
+}
+
+var strictOperators = map[string]string{"==": "===", "!=": "!=="}
+
+// literalTypes maps the type of a literal node to the type of its value.
+var literalTypes = map[string]string{
+	"number":          "number",
+	"string":          "string",
+	"template_string": "string",
+	"true":            "boolean",
+	"false":           "boolean",
+}
+
+// isTypeof returns true if `node` is a `typeof x` expression.
+func isTypeof(node *sitter.Node) bool {
+	return node.Type() == "unary_expression" && operatorOf(node) == "typeof"
+}
+
+// coercionIsImpossible returns true if both operands always have the same type,
+// so that the loose and strict comparisons give the same result, e.g: `typeof x == "string"`.
+func coercionIsImpossible(left, right *sitter.Node) bool {
+	left, right = unwrapParens(left), unwrapParens(right)
+	if isTypeof(left) || isTypeof(right) {
+		other := right
+		if isTypeof(right) {
+			other = left
+		}
+
+		return isTypeof(other) || literalTypes[other.Type()] == "string"
+	}
+
+	leftType, rightType := literalTypes[left.Type()], literalTypes[right.Type()]
+	return leftType != "" && leftType == rightType
+}
+
+func checkStrictEquality(opts *RequireStrictEqualityOptions, ana *one.Analyzer, node *sitter.Node) {
+	operator := node.ChildByFieldName("operator")
+	left, right := node.ChildByFieldName("left"), node.ChildByFieldName("right")
+	if operator == nil || left == nil || right == nil {
+		return
+	}
+
+	loose := operator.Type()
+	strict, ok := strictOperators[loose]
+	if !ok {
+		return
+	}
+
+	if opts.AllowNullComparison && (unwrapParens(left).Type() == "null" || unwrapParens(right).Type() == "null") {
+		return
+	}
+
+	issue := &one.Issue{
+		Message: fmt.Sprintf("Use '%s' instead of '%s' to compare without type coercion", strict, loose),
+		Range:   operator.Range(),
+		Node:    operator,
+	}
+
+	// switching to a strict comparison only keeps the result the same when
+	// no coercion can happen. e.g: `x == null` is also true when `x` is `undefined`.
+	if coercionIsImpossible(left, right) {
+		issue.Fix = &one.Fix{Range: operator.Range(), Replacement: strict}
+		issue.Metadata = map[string]string{one.MetadataFixConfidence: "high"}
+	}
+
+	ana.Report(issue)
+}
+
+// RequireStrictEquality flags comparisons with `==` and `!=`, which coerce their
+// operands to the same type. Comparisons whose operands are known to have the same type
+// (like `typeof x == "string"`) are fixed to use `===` and `!==`. Others are only reported,
+// since the fix could change their result.
+// When `opts` is nil, the default options are used.
+func RequireStrictEquality(opts *RequireStrictEqualityOptions) one.Rule {
+	if opts == nil {
+		opts = &RequireStrictEqualityOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkStrictEquality(opts, ana, node)
+	}
+
+	return one.CreateRule("js-require-strict-equality", "binary_expression", one.LangJs, &entry, nil)
+}
@@ -2,10 +2,51 @@ package js_rules
 
 import "github.com/srijan-paul/deepgrep/pkg/one"
 
-// CreateJsRules returns a list of all python rules
+// CreateJsRules returns the JavaScript rules that are enabled by default.
+// These apply to TypeScript files as well.
+//
+// Only rules that report likely mistakes are enabled by default. These rules are opt-in,
+// and have to be created and added to an analyzer explicitly:
+//   - size and complexity limits: MaxParams, MaxNestedFunctions, MaxFileImports,
+//     CognitiveComplexity, NoNestedTernary, NoIdenticalFunctions.
+//   - style preferences: NoElseReturn, NoExtraParens, NoUnnecessarySemicolon, NoFloatingDecimal,
+//     NoNegatedCondition, NoMixedOperators, ImportOrder, RequireJsDoc,
+//     PreferTemplateLiteral, PreferEarlyReturn, PreferOptionsObject, PreferForOf.
+//   - project conventions: NoConsole, NoParamReassign, NoMagicNumbers, NoBitwise, NoHardcodedUrl.
+//   - rules that overlap with a default rule: RequireStrictEquality (NoDoubleEq), and
+//     NoUnusedVars (UnusedImport, NoUnusedParams and NoDeadStore).
 func CreateJsRules() []one.Rule {
 	return []one.Rule{
 		NoDoubleEq(),
 		UnusedImport(),
+		NoUnreachable(),
+		NoShadowBuiltins(nil),
+		NoDupeKeys(),
+		NoEval(nil),
+		RequireErrorHandling(nil),
+		NoReturnAwait(),
+		NoDeadStore(),
+		NoRedundantBoolean(),
+		NoThrowLiteral(nil),
+		NoSyncInAsync(nil),
+		NoUnusedParams(nil),
+		NoConstantCondition(nil),
+		NoAwaitInLoop(nil),
+		NoDuplicateImports(),
+		NoSelfAssignment(nil),
+		NoUselessRename(),
+		NoArrayIndexKey(),
+		NoPropsMutation(nil),
+		NoCondAssign(nil),
+		NoDefaultParamBeforeRequired(),
+	}
+}
+
+// CreateTsRules returns the rules that only apply to TypeScript files,
+// and are enabled by default (see `CreateJsRules`).
+func CreateTsRules() []one.Rule {
+	return []one.Rule{
+		NoImplicitAny(nil),
+		NoEmptyInterface(nil),
 	}
 }
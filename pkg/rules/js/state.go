@@ -0,0 +1,17 @@
+package js_rules
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// ruleInstances is the number of keys handed out by `instanceKey`.
+var ruleInstances atomic.Int64
+
+// instanceKey returns a key for `Analyzer.Set` that is unique to one instance of a rule.
+// An analyzer can run two instances of the same rule (e.g: with different options),
+// and they shouldn't read or write each other's state.
+// State can't live in the rule itself, since one rule may be used by many analyzers at once.
+func instanceKey(prefix string) string {
+	return fmt.Sprintf("%s#%d", prefix, ruleInstances.Add(1))
+}
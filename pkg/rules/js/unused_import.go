@@ -64,6 +64,6 @@ func checkUnusedImport(r one.Rule, ana *one.Analyzer, importClause *sitter.Node)
 
 func UnusedImport() one.Rule {
 	var exit one.VisitFn = checkUnusedImport
-	return one.CreateRule("import_clause", one.LangJs, nil, &exit)
+	return one.CreateRule("js-unused-import", "import_clause", one.LangJs, nil, &exit)
 }
 
@@ -17,6 +17,6 @@ func checkIfTuple(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
 
 func IfTuple() one.Rule {
 	var entry one.VisitFn = checkIfTuple
-	return one.CreateRule("if_statement", one.LangPy, &entry, nil)
+	return one.CreateRule("py-if-tuple", "if_statement", one.LangPy, &entry, nil)
 }
 
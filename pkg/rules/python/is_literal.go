@@ -41,6 +41,6 @@ func checkComparisonOp(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
 
 func IsLiteral() one.Rule {
 	var entry one.VisitFn = checkComparisonOp 
-	return one.CreateRule("comparison_operator", one.LangPy, &entry, nil)
+	return one.CreateRule("py-is-literal", "comparison_operator", one.LangPy, &entry, nil)
 }
 
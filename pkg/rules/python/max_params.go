@@ -0,0 +1,79 @@
+package python_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type MaxParamsOptions struct {
+	// Max is the maximum number of parameters a function can have.
+	// Defaults to 5 when 0.
+	Max int
+}
+
+const defaultMaxParams = 5
+
+// isMethod returns true if `funcDef` is defined directly in a class body
+func isMethod(funcDef *sitter.Node) bool {
+	parent := funcDef.Parent()
+	if parent != nil && parent.Type() == "decorated_definition" {
+		parent = parent.Parent()
+	}
+
+	return parent != nil && parent.Type() == "block" &&
+		parent.Parent() != nil && parent.Parent().Type() == "class_definition"
+}
+
+// countParams returns the number of parameters in a parameter list.
+// `*args` and `**kwargs` count as one each, and the `*` and `/` separators
+// are not counted. Neither is the `self`/`cls` parameter of a method.
+func countParams(params *sitter.Node, source []byte) int {
+	count := 0
+	for i := 0; i < int(params.NamedChildCount()); i++ {
+		switch params.NamedChild(i).Type() {
+		case "keyword_separator", "positional_separator", "comment":
+		default:
+			count++
+		}
+	}
+
+	funcDef := params.Parent()
+	if count > 0 && funcDef != nil && isMethod(funcDef) {
+		first := params.NamedChild(0).Content(source)
+		if first == "self" || first == "cls" {
+			count--
+		}
+	}
+
+	return count
+}
+
+func checkMaxParams(opts *MaxParamsOptions, ana *one.Analyzer, params *sitter.Node) {
+	max := opts.Max
+	if max == 0 {
+		max = defaultMaxParams
+	}
+
+	if count := countParams(params, ana.ParseResult.Source); count > max {
+		ana.Report(&one.Issue{
+			Message: fmt.Sprintf("Function has %d parameters, which exceeds the maximum of %d", count, max),
+			Range:   params.Range(),
+		})
+	}
+}
+
+// MaxParams flags functions that have too many parameters.
+// When `opts` is nil, the default options are used.
+func MaxParams(opts *MaxParamsOptions) one.Rule {
+	if opts == nil {
+		opts = &MaxParamsOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkMaxParams(opts, ana, node)
+	}
+
+	return one.CreateRule("py-max-params", "parameters", one.LangPy, &entry, nil)
+}
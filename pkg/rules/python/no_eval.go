@@ -0,0 +1,54 @@
+package python_rules
+
+import (
+	"fmt"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoEvalOptions struct {
+	// Functions is a list of callee names to flag, in addition to `eval` and `exec`.
+	// Attribute calls are matched by their full name, like "os.system".
+	Functions []string
+}
+
+var defaultEvalFunctions = []string{"eval", "exec"}
+
+func checkNoEval(banned []string, ana *one.Analyzer, node *sitter.Node) {
+	callee := node.ChildByFieldName("function")
+	if callee == nil {
+		return
+	}
+
+	if callee.Type() != "identifier" && callee.Type() != "attribute" {
+		return
+	}
+
+	name := callee.Content(ana.ParseResult.Source)
+	if !slices.Contains(banned, name) {
+		return
+	}
+
+	ana.Report(&one.Issue{
+		Message:  fmt.Sprintf("Unexpected call to '%s'. Evaluating code at runtime is a security hazard.", name),
+		Severity: one.SeverityError,
+		Range:    node.Range(),
+	})
+}
+
+// NoEval flags calls to `eval` and `exec`, and any other functions listed in `opts`.
+// When `opts` is nil, the default options are used.
+func NoEval(opts *NoEvalOptions) one.Rule {
+	banned := defaultEvalFunctions
+	if opts != nil {
+		banned = append(slices.Clone(defaultEvalFunctions), opts.Functions...)
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkNoEval(banned, ana, node)
+	}
+
+	return one.CreateRule("py-no-eval", "call", one.LangPy, &entry, nil)
+}
@@ -0,0 +1,57 @@
+package python_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoNestedTernaryOptions struct {
+	// MaxDepth is the number of levels that conditional expressions can be nested.
+	// When 0, any nesting is reported.
+	MaxDepth int
+}
+
+// conditionalDepth returns the number of conditional expressions that have
+// `expr` nested in one of their branches.
+func conditionalDepth(expr *sitter.Node) int {
+	depth := 0
+	node := expr
+	for {
+		parent := node.Parent()
+		for parent != nil && parent.Type() == "parenthesized_expression" {
+			node, parent = parent, parent.Parent()
+		}
+
+		// <consequence> if <condition> else <alternative>
+		if parent == nil || parent.Type() != "conditional_expression" || parent.NamedChild(1) == node {
+			return depth
+		}
+
+		depth++
+		node = parent
+	}
+}
+
+func checkNestedTernary(opts *NoNestedTernaryOptions, ana *one.Analyzer, node *sitter.Node) {
+	if conditionalDepth(node) == opts.MaxDepth+1 {
+		ana.Report(&one.Issue{
+			Message: "Do not nest conditional expressions. Use an if-else statement instead",
+			Range:   node.Range(),
+		})
+	}
+}
+
+// NoNestedTernary flags conditional expressions (`a if b else c`) that are
+// nested inside a branch of another conditional expression.
+// When `opts` is nil, the default options are used.
+func NoNestedTernary(opts *NoNestedTernaryOptions) one.Rule {
+	if opts == nil {
+		opts = &NoNestedTernaryOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkNestedTernary(opts, ana, node)
+	}
+
+	return one.CreateRule("py-no-nested-ternary", "conditional_expression", one.LangPy, &entry, nil)
+}
@@ -0,0 +1,118 @@
+package python_rules
+
+import (
+	"fmt"
+	"slices"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoShadowBuiltinsOptions struct {
+	// Builtins is a list of names to check for, in addition to the default builtins.
+	Builtins []string
+}
+
+var defaultBuiltins = []string{
+	"all", "any", "bool", "bytes", "callable", "dict", "dir", "filter",
+	"float", "format", "frozenset", "hash", "help", "id", "input", "int",
+	"iter", "len", "list", "map", "max", "min", "next", "object", "open",
+	"print", "range", "reversed", "set", "sorted", "str", "sum", "tuple",
+	"type", "vars", "zip",
+}
+
+func isInsideFunction(node *sitter.Node) bool {
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		if parent.Type() == "function_definition" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bindingsOfParam returns the identifiers bound by a function parameter.
+func bindingsOfParam(param *sitter.Node) []*sitter.Node {
+	switch param.Type() {
+	case "identifier":
+		return []*sitter.Node{param}
+	case "default_parameter", "typed_default_parameter":
+		return []*sitter.Node{param.ChildByFieldName("name")}
+	case "typed_parameter", "list_splat_pattern", "dictionary_splat_pattern":
+		return one.ChildrenOfType(param, "identifier")
+	default:
+		return nil
+	}
+}
+
+// bindingsOfTarget returns the identifiers bound by the left-hand side of an assignment.
+func bindingsOfTarget(target *sitter.Node) []*sitter.Node {
+	switch target.Type() {
+	case "identifier":
+		return []*sitter.Node{target}
+	case "pattern_list", "tuple_pattern", "list_pattern":
+		var ids []*sitter.Node
+		for i := 0; i < int(target.NamedChildCount()); i++ {
+			ids = append(ids, bindingsOfTarget(target.NamedChild(i))...)
+		}
+		return ids
+	default:
+		return nil
+	}
+}
+
+func checkShadowBuiltins(builtins []string, ana *one.Analyzer, node *sitter.Node) {
+	var bindings []*sitter.Node
+	switch node.Type() {
+	case "parameters", "lambda_parameters":
+		for i := 0; i < int(node.NamedChildCount()); i++ {
+			bindings = append(bindings, bindingsOfParam(node.NamedChild(i))...)
+		}
+
+	case "assignment":
+		if isInsideFunction(node) {
+			bindings = bindingsOfTarget(node.ChildByFieldName("left"))
+		}
+
+	case "function_definition":
+		if isInsideFunction(node) {
+			bindings = []*sitter.Node{node.ChildByFieldName("name")}
+		}
+	}
+
+	for _, id := range bindings {
+		if id == nil {
+			continue
+		}
+
+		name := id.Content(ana.ParseResult.Source)
+		if slices.Contains(builtins, name) {
+			ana.Report(&one.Issue{
+				Message: fmt.Sprintf("'%s' shadows a builtin", name),
+				Range:   id.Range(),
+			})
+		}
+	}
+}
+
+// NoShadowBuiltins flags parameters and local variables that shadow
+// a Python builtin, like `list` or `id`.
+// When `opts` is nil, the default options are used.
+func NoShadowBuiltins(opts *NoShadowBuiltinsOptions) one.Rule {
+	builtins := defaultBuiltins
+	if opts != nil {
+		builtins = append(slices.Clone(defaultBuiltins), opts.Builtins...)
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkShadowBuiltins(builtins, ana, node)
+	}
+
+	return one.CreateMultiNodeRule(
+		"py-no-shadow-builtins",
+		[]string{"parameters", "lambda_parameters", "assignment", "function_definition"},
+		one.LangPy,
+		&entry,
+		nil,
+	)
+}
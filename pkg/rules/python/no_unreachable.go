@@ -0,0 +1,43 @@
+package python_rules
+
+import (
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+func isTerminatingStatement(node *sitter.Node) bool {
+	switch node.Type() {
+	case "return_statement", "raise_statement", "break_statement", "continue_statement":
+		return true
+	default:
+		return false
+	}
+}
+
+func checkUnreachable(r one.Rule, ana *one.Analyzer, block *sitter.Node) {
+	terminated := false
+	for i := 0; i < int(block.NamedChildCount()); i++ {
+		stmt := block.NamedChild(i)
+		if stmt.Type() == "comment" {
+			continue
+		}
+
+		if terminated {
+			ana.Report(&one.Issue{
+				Message: "Unreachable code",
+				Range:   stmt.Range(),
+			})
+			return
+		}
+
+		terminated = isTerminatingStatement(stmt)
+	}
+}
+
+// NoUnreachable flags statements that appear after a `return`, `raise`,
+// `break`, or `continue` in the same block.
+// Only the first unreachable statement in a block is reported.
+func NoUnreachable() one.Rule {
+	var entry one.VisitFn = checkUnreachable
+	return one.CreateRule("py-no-unreachable", "block", one.LangPy, &entry, nil)
+}
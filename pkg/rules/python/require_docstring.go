@@ -0,0 +1,86 @@
+package python_rules
+
+import (
+	"fmt"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type RequireDocstringOptions struct {
+	// IncludePrivate also requires docstrings for functions and classes
+	// whose name starts with an underscore (e.g: `_helper`, `__init__`).
+	IncludePrivate bool
+}
+
+// hasDocstring returns true if the first statement in the body
+// of a function or class definition is a string.
+func hasDocstring(definition *sitter.Node) bool {
+	body := definition.ChildByFieldName("body")
+	if body == nil || body.NamedChildCount() == 0 {
+		return false
+	}
+
+	first := body.NamedChild(0)
+	return first.Type() == "expression_statement" &&
+		first.NamedChildCount() == 1 &&
+		first.NamedChild(0).Type() == "string"
+}
+
+// isNestedInFunction returns true for definitions inside the body of a function,
+// which aren't part of a module's API.
+func isNestedInFunction(definition *sitter.Node) bool {
+	for parent := definition.Parent(); parent != nil; parent = parent.Parent() {
+		if parent.Type() == "function_definition" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func checkDocstring(opts *RequireDocstringOptions, ana *one.Analyzer, node *sitter.Node) {
+	name := node.ChildByFieldName("name")
+	if name == nil || isNestedInFunction(node) || hasDocstring(node) {
+		return
+	}
+
+	source := ana.ParseResult.Source
+	nameStr := name.Content(source)
+	if !opts.IncludePrivate && strings.HasPrefix(nameStr, "_") {
+		return
+	}
+
+	kind := "function"
+	if node.Type() == "class_definition" {
+		kind = "class"
+	}
+
+	ana.Report(&one.Issue{
+		Message: fmt.Sprintf("Missing docstring for %s '%s'", kind, nameStr),
+		Range:   node.Range(),
+		Node:    node,
+	})
+}
+
+// RequireDocstring flags public functions and classes that don't
+// start with a docstring. Names starting with `_` are considered private.
+// When `opts` is nil, the default options are used.
+func RequireDocstring(opts *RequireDocstringOptions) one.Rule {
+	if opts == nil {
+		opts = &RequireDocstringOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkDocstring(opts, ana, node)
+	}
+
+	return one.CreateMultiNodeRule(
+		"py-require-docstring",
+		[]string{"function_definition", "class_definition"},
+		one.LangPy,
+		&entry,
+		nil,
+	)
+}
@@ -2,11 +2,15 @@ package python_rules
 
 import "github.com/srijan-paul/deepgrep/pkg/one"
 
-// CreatePyRules returns a list of all python rules
+// CreatePyRules returns the python rules that are enabled by default.
+// Like the JavaScript rules, only rules that report likely mistakes are enabled by default.
+// MaxParams, NoNestedTernary and RequireDocstring are opt-in.
 func CreatePyRules() []one.Rule {
 	return []one.Rule{
 		IsLiteral(),
 		IfTuple(),
+		NoUnreachable(),
+		NoShadowBuiltins(nil),
+		NoEval(nil),
 	}
 }
-
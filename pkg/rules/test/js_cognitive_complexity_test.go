@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestCognitiveComplexity(t *testing.T) {
+	testCase := &TestCase{
+		Name: "cognitive-complexity.js",
+		Rule: js_rules.CognitiveComplexity(&js_rules.CognitiveComplexityOptions{Max: 3}),
+		Raise: []ShouldRaise{
+			{
+				// if (+1), for (+2, nested once), if (+3, nested twice)
+				Code: `function f(xs) {
+					if (xs) {
+						for (const x of xs) {
+							if (x) { return x }
+						}
+					}
+				}`,
+				Expected: []ExpectedIssue{{Message: "'f' has a cognitive complexity of 6, which exceeds the maximum of 3"}},
+			},
+			{
+				// if (+1), && (+1), || (+1), else if (+1), else (+1)
+				Code: `const g = (a, b, c) => {
+					if (a && b || c) { return 1 } else if (b) { return 2 } else { return 3 }
+				}`,
+				Expected: []ExpectedIssue{{Message: "'g' has a cognitive complexity of 5, which exceeds the maximum of 3"}},
+			},
+			{
+				// nested functions increase the nesting: while (+1), arrow function, if (+3), ternary (+4)
+				Code: `xs.forEach(function (x) {
+					while (x) { setTimeout(() => { if (x) { x = x > 1 ? 0 : 1 } }) }
+				})`,
+				Expected: []ExpectedIssue{{Message: "Function has a cognitive complexity of 8, which exceeds the maximum of 3"}},
+			},
+			{
+				// for (+1), switch (+1), catch (+1), for (+1), labeled continue (+1)
+				Code: `function h(x) {
+					outer: for (;;) { break }
+					switch (x) { case 1: break }
+					try {} catch (e) {}
+					for (;;) { continue outer }
+				}`,
+				Expected: []ExpectedIssue{{Message: "'h' has a cognitive complexity of 5, which exceeds the maximum of 3"}},
+			},
+		},
+		Pass: []string{
+			// a && b && c is a single sequence
+			`function f(a, b, c) { if (a && b && c) { return 1 } }`,
+			`function f(a) { if (a) {} else {} if (a) {} }`,
+			`function f() { return 1 }`,
+		},
+	}
+	testCase.Run(t)
+}
@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportOrder(t *testing.T) {
+	testCase := &TestCase{
+		Name: "import-order.js",
+		Rule: js_rules.ImportOrder(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `import b from "b"
+import a from "a"
+import c from "./c"`,
+				Expected: []ExpectedIssue{{
+					Message: "Import of 'a' should come before import of 'b'",
+					Start:   &sitter.Point{Row: 1, Column: 0},
+				}},
+			},
+			{
+				Code: `import { x } from "./x"
+import fs from "node:fs"
+import path from "path"`,
+				Expected: []ExpectedIssue{{Message: "Import of 'node:fs' should come before import of './x'"}},
+			},
+		},
+		Pass: []string{
+			`import fs from "fs"
+import react from "react"
+import { a } from "../a"
+import "./polyfill"
+import { b } from "./b"`,
+		},
+	}
+	testCase.Run(t)
+
+	internal := &TestCase{
+		Name: "import-order.ts",
+		Rule: js_rules.ImportOrder(&js_rules.ImportOrderOptions{Internal: []string{"@app/**"}}),
+		Raise: []ShouldRaise{
+			{
+				Code: `import { db } from "@app/db"
+import lodash from "lodash"`,
+				Expected: []ExpectedIssue{{Message: "Import of 'lodash' should come before import of '@app/db'"}},
+			},
+		},
+		Pass: []string{`import lodash from "lodash"
+import { db } from "@app/db"`},
+	}
+	internal.Run(t)
+}
+
+func TestImportOrderFix(t *testing.T) {
+	rule := js_rules.ImportOrder(nil)
+	assert.Equal(t, `import fs from "fs"
+import a from "a"
+import b from "./b"
+foo()`, fixedSource(t, rule, `import b from "./b"
+import a from "a"
+import fs from "fs"
+foo()`))
+
+	// imports separated by other statements aren't moved
+	code := `import b from "b"
+foo()
+import a from "a"`
+	assert.Equal(t, code, fixedSource(t, rule, code))
+}
@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxFileImports(t *testing.T) {
+	var imports []string
+	for _, name := range strings.Split("abcdefghijk", "") {
+		imports = append(imports, `import `+name+` from "`+name+`"`)
+	}
+
+	testCase := &TestCase{
+		Name: "max-file-imports.js",
+		Rule: js_rules.MaxFileImports(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: strings.Join(imports, "\n"),
+				Expected: []ExpectedIssue{{
+					Message: "File has 11 imports. Maximum allowed is 10",
+					Start:   &sitter.Point{Row: 0, Column: 0},
+					End:     &sitter.Point{Row: 0, Column: 0},
+				}},
+			},
+		},
+		Pass: []string{
+			strings.Join(imports[:10], "\n"),
+			`const a = require("a")`,
+		},
+	}
+	testCase.Run(t)
+
+	typeImports := `
+import a from "a"
+import type { B } from "b"
+import type { C } from "c"`
+
+	customCase := &TestCase{
+		Name: "max-file-imports.ts",
+		Rule: js_rules.MaxFileImports(&js_rules.MaxFileImportsOptions{Max: 2}),
+		Raise: []ShouldRaise{
+			{Code: typeImports, Expected: []ExpectedIssue{{Message: "File has 3 imports. Maximum allowed is 2"}}},
+		},
+	}
+	customCase.Run(t)
+
+	ignoreTypesCase := &TestCase{
+		Name: "max-file-imports-types.ts",
+		Rule: js_rules.MaxFileImports(&js_rules.MaxFileImportsOptions{Max: 2, IgnoreTypeImports: true}),
+		Pass: []string{typeImports, `import { type B } from "b"`},
+	}
+	ignoreTypesCase.Run(t)
+	// two instances in one analyzer count the imports separately.
+	parsed, err := one.ParseString("import a from \"a\"\nimport b from \"b\"", one.LangJs)
+	require.NoError(t, err)
+	issues := one.NewAnalyzer(parsed, []one.Rule{
+		js_rules.MaxFileImports(&js_rules.MaxFileImportsOptions{Max: 1}),
+		js_rules.MaxFileImports(&js_rules.MaxFileImportsOptions{Max: 3}),
+	}).Analyze()
+	require.Equal(t, 1, len(issues))
+	assert.Equal(t, "File has 2 imports. Maximum allowed is 1", issues[0].Message)
+}
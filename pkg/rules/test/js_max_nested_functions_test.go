@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxNestedFunctions(t *testing.T) {
+	testCase := &TestCase{
+		Name: "max-nested-functions.js",
+		Rule: js_rules.MaxNestedFunctions(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `function a() { function b() { function c() { function d() {} } } }`,
+				Expected: []ExpectedIssue{{
+					Message: "'d' is nested 4 levels deep. Maximum allowed is 3",
+					Start:   &sitter.Point{Row: 0, Column: 54},
+					End:     &sitter.Point{Row: 0, Column: 55},
+				}},
+			},
+			{
+				Code: `const f = () => () => () => { const g = () => 1; return () => 2 }`,
+				Expected: []ExpectedIssue{
+					{Message: "'g' is nested 4 levels deep. Maximum allowed is 3"},
+					{Message: "Function is nested 4 levels deep. Maximum allowed is 3"},
+				},
+			},
+		},
+		Pass: []string{
+			`function a() { function b() { function c() {} } }`,
+			// the depth goes back down after leaving a function.
+			`function a() { function b() { function c() {} } function d() { function e() {} } }`,
+			`function a() { function b() {} } function c() { function d() { function e() {} } }`,
+			`class A { m() { return function () { return { n() { return 1 } } } } }`,
+		},
+	}
+	testCase.Run(t)
+
+	ignoreArrows := &TestCase{
+		Name: "max-nested-functions-arrows.js",
+		Rule: js_rules.MaxNestedFunctions(&js_rules.MaxNestedFunctionsOptions{Max: 1, IgnoreArrowFunctions: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `function a() { xs.map(x => { function b() {} }) }`,
+				Expected: []ExpectedIssue{{Message: "'b' is nested 2 levels deep. Maximum allowed is 1"}},
+			},
+		},
+		Pass: []string{
+			`function a() { xs.map(x => ys.map(y => x + y)) }`,
+		},
+	}
+	ignoreArrows.Run(t)
+	// two instances in one analyzer track the depth separately.
+	parsed, err := one.ParseString(`function a() { function b() {} }`, one.LangJs)
+	require.NoError(t, err)
+	issues := one.NewAnalyzer(parsed, []one.Rule{
+		js_rules.MaxNestedFunctions(&js_rules.MaxNestedFunctionsOptions{Max: 1}),
+		js_rules.MaxNestedFunctions(&js_rules.MaxNestedFunctionsOptions{Max: 2}),
+	}).Analyze()
+	require.Equal(t, 1, len(issues))
+	assert.Equal(t, "'b' is nested 2 levels deep. Maximum allowed is 1", issues[0].Message)
+}
@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestJsMaxParams(t *testing.T) {
+	testCase := &TestCase{
+		Name: "max-params.ts",
+		Rule: js_rules.MaxParams(nil),
+		Raise: []ShouldRaise{
+			{
+				Code:     `function f(a, b, c, d, e, f) {}`,
+				Expected: []ExpectedIssue{{Message: "Function has 6 parameters, which exceeds the maximum of 5"}},
+			},
+			{
+				Code:     `const f = (a, { b, c }, [d], e = 1, f, ...g) => {}`,
+				Expected: []ExpectedIssue{{Message: "Function has 6 parameters, which exceeds the maximum of 5"}},
+			},
+		},
+		Pass: []string{
+			`function f(a, b, c, d, e) {}`,
+			`function f(this: Window, a, b, c, d, e) {}`,
+			`function f({ a, b, c, d, e, f }) {}`,
+		},
+	}
+	testCase.Run(t)
+
+	configured := &TestCase{
+		Name: "max-params.js",
+		Rule: js_rules.MaxParams(&js_rules.MaxParamsOptions{Max: 2}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `class A { m(a, b, ...c) {} }`,
+				Expected: []ExpectedIssue{{Message: "Function has 3 parameters, which exceeds the maximum of 2"}},
+			},
+		},
+	}
+	configured.Run(t)
+}
@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoArrayIndexKey(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-array-index-key.jsx",
+		Rule: js_rules.NoArrayIndexKey(),
+		Raise: []ShouldRaise{
+			{
+				Code: "items.map((item, i) => <li key={i}>{item}</li>)",
+				Expected: []ExpectedIssue{{
+					Message: "Do not use the array index 'i' as a key. Use a stable id of the item instead",
+					Start:   &sitter.Point{Row: 0, Column: 27},
+					End:     &sitter.Point{Row: 0, Column: 34},
+				}},
+			},
+			{
+				Code: "items.map(function (x, idx) { return <Row key={`row-${idx}`} /> })",
+				Expected: []ExpectedIssue{{
+					Message: "Do not use the array index 'idx' as a key. Use a stable id of the item instead",
+				}},
+			},
+			{
+				Code: "rows.flatMap((row, n) => row.cells.map(cell => <Cell key={cell.id + n} />))",
+				Expected: []ExpectedIssue{{
+					Message: "Do not use the array index 'n' as a key. Use a stable id of the item instead",
+				}},
+			},
+		},
+		Pass: []string{
+			"items.map((item) => <li key={item.id}>{item}</li>)",
+			"items.map((item, i) => <li key={items[i].id} />)",
+			"const i = 0; items.map((item) => <li key={i} />)",
+			"items.filter((item, i) => i > 0).map(item => <li key={item.id} />)",
+			"items.map((item, i) => <li id={i} key={item.id} />)",
+			"function f(a, i) { return <li key={i} /> }",
+		},
+	}
+	testCase.Run(t)
+}
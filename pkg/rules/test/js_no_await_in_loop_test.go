@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoAwaitInLoop(t *testing.T) {
+	message := "Unexpected 'await' inside a loop. Consider running the promises concurrently with 'Promise.all'"
+	testCase := &TestCase{
+		Name: "no-await-in-loop.js",
+		Rule: js_rules.NoAwaitInLoop(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `async function f() { for (const x of xs) { await g(x) } }`,
+				Expected: []ExpectedIssue{{
+					Message: message,
+					Start:   &sitter.Point{Row: 0, Column: 43},
+					End:     &sitter.Point{Row: 0, Column: 53},
+				}},
+			},
+			{
+				Code:     `async function f() { for (let i = 0; i < await n(); i++) {} }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+			{
+				Code:     `async function f() { while (x) { if (y) { await g() } } }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+			{
+				Code:     `async function f() { do { await g() } while (x) }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+			{
+				Code:     `async function f() { for (const k in obj) { const v = await g(k) } }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+		},
+		Pass: []string{
+			`async function f() { for (const x of await list()) {} }`,
+			`async function f() { for (let i = await start(); i < 10; i++) {} }`,
+			`async function f() { for await (const x of stream) { await g(x) } }`,
+			`async function f() { for (const x of xs) { promises.push(async () => { await g(x) }) } }`,
+			`async function f() { await Promise.all(xs.map(async (x) => await g(x))) }`,
+		},
+	}
+	testCase.Run(t)
+
+	allowWhile := &TestCase{
+		Name: "no-await-in-loop-while.js",
+		Rule: js_rules.NoAwaitInLoop(&js_rules.NoAwaitInLoopOptions{AllowWhileLoops: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `async function f() { while (x) { for (const y of ys) { await g(y) } } }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+		},
+		Pass: []string{
+			`async function f() { while (!done) { done = await poll() } }`,
+			`async function f() { do { await sleep(100) } while (retry()) }`,
+		},
+	}
+	allowWhile.Run(t)
+}
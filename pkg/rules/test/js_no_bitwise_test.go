@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoBitwise(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-bitwise.js",
+		Rule: js_rules.NoBitwise(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "if (a & b) {}",
+				Expected: []ExpectedIssue{{
+					Message: "Unexpected use of bitwise operator '&'. Did you mean '&&'?",
+					Start:   &sitter.Point{Row: 0, Column: 6},
+					End:     &sitter.Point{Row: 0, Column: 7},
+				}},
+			},
+			{
+				Code: "x = ~y ^ (z << 2) >>> 1; x |= 1",
+				Expected: []ExpectedIssue{
+					{Message: "Unexpected use of bitwise operator '~'"},
+					{Message: "Unexpected use of bitwise operator '^'"},
+					{Message: "Unexpected use of bitwise operator '<<'"},
+					{Message: "Unexpected use of bitwise operator '>>>'"},
+					{Message: "Unexpected use of bitwise operator '|='. Did you mean '||='?"},
+				},
+			},
+		},
+		Pass: []string{"a && b || !c", "x += 1", "a < b > c"},
+	}
+	testCase.Run(t)
+
+	custom := &TestCase{
+		Name: "src/flags.js",
+		Rule: js_rules.NoBitwise(&js_rules.NoBitwiseOptions{
+			Allow:   []string{"|", "|="},
+			AllowIn: one.PathPatterns{"src/bits/**"},
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "flags |= READ | WRITE; if (flags & READ) {}",
+				Expected: []ExpectedIssue{{Message: "Unexpected use of bitwise operator '&'. Did you mean '&&'?"}},
+			},
+		},
+	}
+	custom.Run(t)
+
+	exempt := &TestCase{
+		Name: "src/bits/mask.js",
+		Rule: js_rules.NoBitwise(&js_rules.NoBitwiseOptions{AllowIn: one.PathPatterns{"src/bits/**"}}),
+		Pass: []string{"const mask = (1 << n) - 1 & x"},
+	}
+	exempt.Run(t)
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+const condAssignMessage = "Unexpected assignment in a condition. Did you mean to compare with '==='?"
+
+func TestNoCondAssign(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-cond-assign.js",
+		Rule: js_rules.NoCondAssign(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "if (x = 5) { run() }",
+				Expected: []ExpectedIssue{{
+					Message: condAssignMessage,
+					Start:   &sitter.Point{Row: 0, Column: 4},
+					End:     &sitter.Point{Row: 0, Column: 9},
+				}},
+			},
+			{
+				Code: "while (node = node.next) {}; do {} while (x = y); for (;x = 1;) {}; if (n += 1) {}",
+				Expected: []ExpectedIssue{
+					{Message: condAssignMessage},
+					{Message: condAssignMessage},
+					{Message: condAssignMessage},
+					{Message: condAssignMessage},
+				},
+			},
+		},
+		Pass: []string{
+			"if (x === 5) {}",
+			"while ((match = re.exec(s))) {}",
+			"if ((x = foo())) {}",
+			"for (let i = 0; i < n; i = i + 1) {}",
+			"if (x) { y = 1 }",
+			"if ((x = 1) > 0) {}",
+		},
+	}
+	testCase.Run(t)
+
+	strict := &TestCase{
+		Name: "no-cond-assign-strict.js",
+		Rule: js_rules.NoCondAssign(&js_rules.NoCondAssignOptions{}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "while ((match = re.exec(s))) {}",
+				Expected: []ExpectedIssue{{Message: condAssignMessage}},
+			},
+		},
+		Pass: []string{"if ((x = 1) > 0) {}"},
+	}
+	strict.Run(t)
+}
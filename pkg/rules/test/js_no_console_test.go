@@ -0,0 +1,40 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoConsole(t *testing.T) {
+	rule := js_rules.NoConsole(nil)
+	expected := []ExpectedIssue{{Message: "Unexpected call to 'console'. Remove it, or use a logger instead."}}
+
+	srcCase := &TestCase{
+		Name: "src/app.js",
+		Rule: rule,
+		Raise: []ShouldRaise{
+			{Code: `console.log("hi")`, Expected: expected},
+			{Code: `function f() { console.error(new Error()) }`, Expected: expected},
+		},
+		Pass: []string{
+			`logger.log("hi")`,
+			`log("hi")`,
+		},
+	}
+	srcCase.Run(t)
+
+	testFileCase := &TestCase{
+		Name: "src/app.test.js",
+		Rule: rule,
+		Pass: []string{`console.log("hi")`},
+	}
+	testFileCase.Run(t)
+
+	customCase := &TestCase{
+		Name: "scripts/build.js",
+		Rule: js_rules.NoConsole(&js_rules.NoConsoleOptions{AllowIn: []string{"scripts/**"}}),
+		Pass: []string{`console.log("hi")`},
+	}
+	customCase.Run(t)
+}
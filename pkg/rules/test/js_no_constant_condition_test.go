@@ -0,0 +1,59 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoConstantCondition(t *testing.T) {
+	message := "Unexpected constant condition"
+	testCase := &TestCase{
+		Name: "no-constant-condition.js",
+		Rule: js_rules.NoConstantCondition(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `if (true) {}`,
+				Expected: []ExpectedIssue{{
+					Message: message,
+					Start:   &sitter.Point{Row: 0, Column: 3},
+					End:     &sitter.Point{Row: 0, Column: 9},
+				}},
+			},
+			{Code: `while (1) {}`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `if (x || true) {}`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `if (false && x) {}`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `if (!"") {}`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `if (1 + 2 > 2) {}`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `if (x = 1) {}`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `const y = [] ? a : b`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: "if (`abc`) {}", Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `for (;typeof x;) {}`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `do {} while (void 0)`, Expected: []ExpectedIssue{{Message: message}}},
+		},
+		Pass: []string{
+			`if (x) {}`,
+			`if (x || y) {}`,
+			`if (x && true) {}`,
+			"if (`${x}`) {}",
+			`while (true) {}`,
+			`do {} while (true)`,
+			`for (;;) {}`,
+			`for (let i = 0; i < 10; i++) {}`,
+			`const y = x ? a : b`,
+		},
+	}
+	testCase.Run(t)
+
+	disallowWhileTrue := &TestCase{
+		Name: "no-constant-condition-loops.js",
+		Rule: js_rules.NoConstantCondition(&js_rules.NoConstantConditionOptions{}),
+		Raise: []ShouldRaise{
+			{Code: `while (true) {}`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `for (;true;) {}`, Expected: []ExpectedIssue{{Message: message}}},
+		},
+		Pass: []string{`while (x) {}`},
+	}
+	disallowWhileTrue.Run(t)
+}
@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoDeadStore(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-dead-store.js",
+		Rule: js_rules.NoDeadStore(),
+		Raise: []ShouldRaise{
+			{
+				Code: `function f() { let x = 1; x = 2; return x }`,
+				Expected: []ExpectedIssue{{
+					Message: "Value assigned to 'x' is never read",
+					Start:   &sitter.Point{Row: 0, Column: 19},
+					End:     &sitter.Point{Row: 0, Column: 24},
+				}},
+			},
+			{
+				Code:     `function f(a) { a = 1; a = 2; use(a) }`,
+				Expected: []ExpectedIssue{{Message: "Value assigned to 'a' is never read"}},
+			},
+			{
+				Code:     `function f() { let x = g(); use(x); x = h() }`,
+				Expected: []ExpectedIssue{{Message: "Value assigned to 'x' is never read"}},
+			},
+			{
+				Code:     `function f(c) { let x; if (c) { x = 1 } x = 2; return x }`,
+				Expected: []ExpectedIssue{{Message: "Value assigned to 'x' is never read"}},
+			},
+			{
+				Code: `const f = () => { let x = 1; x++ }`,
+				Expected: []ExpectedIssue{
+					{Message: "Value assigned to 'x' is never read"},
+				},
+			},
+		},
+		Pass: []string{
+			`function f() { let x = 1; x = x + 1; return x }`,
+			`function f(c) { let x = 1; if (c) { x = 2 } return x }`,
+			`function f(c) { let x = 1; c && (x = 2); return x }`,
+			`function f() { let i = 0; while (i < 10) { i++ } }`,
+			`function f() { let x = 1; const g = () => x; x = 2; return g }`,
+			`function f() { let x = 1; try { x = g(); x = h() } catch (e) { use(x) } }`,
+			`function f(xs) { let x; for (x of xs) { if (x) break } return x }`,
+			`function f() { for (let i = 0; i < 3; i++) { use(i) } }`,
+			`function f() { let x = 1; while (c) { x = 2; if (d) break; x = 3 } return x }`,
+			`let x = 1; x = 2`,
+		},
+	}
+	testCase.Run(t)
+}
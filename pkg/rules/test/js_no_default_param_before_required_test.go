@@ -0,0 +1,52 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoDefaultParamBeforeRequired(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-default-param-before-required.js",
+		Rule: js_rules.NoDefaultParamBeforeRequired(),
+		Raise: []ShouldRaise{
+			{
+				Code: "function f(a = 1, b) {}",
+				Expected: []ExpectedIssue{{
+					Message: "Required parameter 'b' comes after 'a', which has a default value",
+					Start:   &sitter.Point{Row: 0, Column: 18},
+					End:     &sitter.Point{Row: 0, Column: 19},
+				}},
+			},
+			{
+				Code: "const g = ({ x } = {}, y, z = 2, w, ...rest) => {}",
+				Expected: []ExpectedIssue{
+					{Message: "Required parameter 'y' comes after '{ x }', which has a default value"},
+					{Message: "Required parameter 'w' comes after '{ x }', which has a default value"},
+				},
+			},
+		},
+		Pass: []string{
+			"function f(a, b = 1) {}",
+			"function f(a, b = 1, ...rest) {}",
+			"const g = (a, { b } = {}) => {}",
+			"class A { m(a, b = 2, c = 3) {} }",
+		},
+	}
+	testCase.Run(t)
+
+	ts := &TestCase{
+		Name: "no-default-param-before-required.ts",
+		Rule: js_rules.NoDefaultParamBeforeRequired(),
+		Raise: []ShouldRaise{
+			{
+				Code:     "function f(a: number = 1, b: string) {}",
+				Expected: []ExpectedIssue{{Message: "Required parameter 'b' comes after 'a', which has a default value"}},
+			},
+		},
+		Pass: []string{"function f(a = 1, b?: string, ...rest: number[]) {}"},
+	}
+	ts.Run(t)
+}
@@ -0,0 +1,48 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoDupeKeys(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-dupe-keys.js",
+		Rule: js_rules.NoDupeKeys(),
+		Raise: []ShouldRaise{
+			{
+				Code:     `x = { a: 1, "a": 2 }`,
+				Expected: []ExpectedIssue{{Message: "Duplicate key 'a' overwrites an earlier property"}},
+			},
+			{
+				Code: "x = { b, ['b']: 1, 1: 2, 0x1: 3, [`c`]: 1, c() {} }",
+				Expected: []ExpectedIssue{
+					{Message: "Duplicate key 'b' overwrites an earlier property"},
+					{Message: "Duplicate key '1' overwrites an earlier property"},
+					{Message: "Duplicate key 'c' overwrites an earlier property"},
+				},
+			},
+		},
+		Pass: []string{
+			`x = { a: 1, b: 2, [a]: 3, [a]: 4 }`,
+			`x = { get a() {}, set a(v) {} }`,
+			"x = { [`${a}`]: 1, [`${a}`]: 2, ...a, ...a }",
+		},
+	}
+	testCase.Run(t)
+}
+
+func TestNoDupeKeysRelatedLocation(t *testing.T) {
+	parsed, err := one.Parse("file.js", []byte("x = { a: 1, a: 2 }"), one.LangJs, one.LangJs.Grammar())
+	require.NoError(t, err)
+
+	issues := one.NewAnalyzer(parsed, []one.Rule{js_rules.NoDupeKeys()}).Analyze()
+	require.Equal(t, 1, len(issues))
+	assert.Equal(t, uint32(12), issues[0].Range.StartPoint.Column)
+	require.Equal(t, 1, len(issues[0].Related))
+	assert.Equal(t, uint32(6), issues[0].Related[0].Range.StartPoint.Column)
+}
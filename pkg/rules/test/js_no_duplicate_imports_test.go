@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoDuplicateImports(t *testing.T) {
+	message := "'./utils' is already imported. Merge the imports into a single statement"
+	testCase := &TestCase{
+		Name: "no-duplicate-imports.ts",
+		Rule: js_rules.NoDuplicateImports(),
+		Raise: []ShouldRaise{
+			{
+				Code: "import { a } from './utils'\nimport { b } from './utils'",
+				Expected: []ExpectedIssue{{
+					Message: message,
+					Start:   &sitter.Point{Row: 1, Column: 0},
+					End:     &sitter.Point{Row: 1, Column: 27},
+				}},
+			},
+			{
+				Code:     "import x from './utils'\nimport 'other'\nimport { b } from \"./utils\"\nimport * as u from './utils'",
+				Expected: []ExpectedIssue{{Message: message}, {Message: message}},
+			},
+		},
+		Pass: []string{
+			"import { a } from './utils'\nimport { b } from './other'",
+			"import { a } from './utils'\nimport type { T } from './utils'",
+		},
+	}
+	testCase.Run(t)
+
+	rule := js_rules.NoDuplicateImports()
+	assert.Equal(t,
+		"import { a, b as c } from './utils';\nfoo()\n",
+		fixedSource(t, rule, "import { a } from './utils';\nfoo()\nimport { b as c, a } from './utils';\n"),
+	)
+	assert.Equal(t,
+		"import d, { a, b } from \"m\"\nimport \"side-effect\"\n",
+		fixedSource(t, rule, "import { a } from \"m\"\nimport \"side-effect\"\n  import d from \"m\"\nimport { b } from \"m\"\n"),
+	)
+	assert.Equal(t,
+		"import type { A, B } from 'm'\nimport { c } from 'm'",
+		fixedSource(t, rule, "import type { A } from 'm'\nimport { c } from 'm'\nimport type { B } from 'm'"),
+	)
+
+	// namespace imports can't be merged with named imports.
+	namespaced := "import * as m from 'm'\nimport { a } from 'm'"
+	assert.Equal(t, namespaced, fixedSource(t, rule, namespaced))
+}
@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoElseReturn(t *testing.T) {
+	expected := []ExpectedIssue{{Message: "Unnecessary 'else' after 'return'"}}
+	testCase := &TestCase{
+		Name: "no-else-return.js",
+		Rule: js_rules.NoElseReturn(),
+		Raise: []ShouldRaise{
+			{Code: `function f() { if (a) { return 1 } else { return 2 } }`, Expected: expected},
+			{Code: `function f() { if (a) throw x; else foo() }`, Expected: expected},
+			{Code: `function f() { if (a) return 1; else if (b) return 2; else return 3 }`, Expected: expected},
+		},
+		Pass: []string{
+			`function f() { if (a) { foo() } else { return 2 } }`,
+			`function f() { if (a) return 1; else if (b) foo(); else return 3 }`,
+			`function f() { if (a) return 1; else if (b) return 2 }`,
+			`function f() { if (a) return 1; else /* c */ if (b) foo() }`,
+			`function f() { if (a) { return 1 } return 2 }`,
+		},
+	}
+	testCase.Run(t)
+}
+
+func TestNoElseReturnFix(t *testing.T) {
+	rule := js_rules.NoElseReturn()
+
+	assert.Equal(t, `function f() {
+	if (a) {
+		return 1
+	}
+	foo()
+	return 2
+}`, fixedSource(t, rule, `function f() {
+	if (a) {
+		return 1
+	} else {
+		foo()
+		return 2
+	}
+}`))
+
+	assert.Equal(t, `if (a) return 1; else if (b) return 2;
+bar()`, fixedSource(t, rule, `if (a) return 1; else if (b) return 2; else bar()`))
+
+	// comments before `else` are kept
+	assert.Equal(t, "if (a) { return 1 } /* c */\nb()", fixedSource(t, rule, "if (a) { return 1 } /* c */ else { b() }"))
+
+	// not safe to fix
+	unsafe := []string{
+		`if (a) { return 1 } else /* c */ { b() }`,
+		`if (a) { return 1 } else // c
+{ b() }`,
+		`while (x) if (a) return; else foo()`,
+		`function f() { if (a) { return 1 } else { let x = 1; return x } }`,
+	}
+	for _, code := range unsafe {
+		assert.Equal(t, code, fixedSource(t, rule, code))
+	}
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsNoEval(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-eval.js",
+		Rule: js_rules.NoEval(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `eval("1 + 1")`,
+				Expected: []ExpectedIssue{{
+					Message: "Unexpected call to 'eval'. Evaluating code at runtime is a security hazard.",
+				}},
+			},
+			{
+				Code:     `function f(code) { return eval(code) }`,
+				Expected: []ExpectedIssue{{Message: "Unexpected call to 'eval'. Evaluating code at runtime is a security hazard."}},
+			},
+		},
+		Pass: []string{
+			`evaluate("1 + 1")`,
+			`obj.eval("1 + 1")`,
+			`const eval2 = 1`,
+		},
+	}
+	testCase.Run(t)
+
+	customCase := &TestCase{
+		Name: "no-eval-custom.js",
+		Rule: js_rules.NoEval(&js_rules.NoEvalOptions{Functions: []string{"vm.runInThisContext"}}),
+		Raise: []ShouldRaise{
+			{
+				Code: `vm.runInThisContext(src); eval(src)`,
+				Expected: []ExpectedIssue{
+					{Message: "Unexpected call to 'vm.runInThisContext'. Evaluating code at runtime is a security hazard."},
+					{Message: "Unexpected call to 'eval'. Evaluating code at runtime is a security hazard."},
+				},
+			},
+		},
+	}
+	customCase.Run(t)
+}
+
+func TestJsNoEvalMetadata(t *testing.T) {
+	parsed, err := one.Parse("no-eval.js", []byte(`eval(src)`), one.LangJs, one.LangJs.Grammar())
+	require.NoError(t, err)
+
+	issues := one.NewAnalyzer(parsed, []one.Rule{js_rules.NoEval(nil)}).Analyze()
+	require.Len(t, issues, 1)
+	require.Equal(t, "CWE-95", issues[0].Metadata[one.MetadataCWE])
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoExtraParens(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-extra-parens.js",
+		Rule: js_rules.NoExtraParens(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "function f(x) { return (x); }",
+				Expected: []ExpectedIssue{{
+					Message: "Unnecessary parentheses around expression",
+					Start:   &sitter.Point{Row: 0, Column: 23},
+					End:     &sitter.Point{Row: 0, Column: 26},
+				}},
+			},
+			{
+				Code:     "const a = ('hello') + (1)",
+				Expected: []ExpectedIssue{{Message: "Unnecessary parentheses around expression"}, {Message: "Unnecessary parentheses around expression"}},
+			},
+			{
+				Code:     "(foo());",
+				Expected: []ExpectedIssue{{Message: "Unnecessary parentheses around expression"}},
+			},
+			{
+				// only the inner parentheses are redundant
+				Code:     "if ((a)) {}",
+				Expected: []ExpectedIssue{{Message: "Unnecessary parentheses around expression"}},
+			},
+			{
+				Code: "const a = (b + c); x = (y * 2); x += (y * 2)",
+				Expected: []ExpectedIssue{
+					{Message: "Unnecessary parentheses around expression"},
+					{Message: "Unnecessary parentheses around expression"},
+					{Message: "Unnecessary parentheses around expression"},
+				},
+			},
+			{
+				Code:     "(foo()); (functions.run());",
+				Expected: []ExpectedIssue{{Message: "Unnecessary parentheses around expression"}, {Message: "Unnecessary parentheses around expression"}},
+			},
+		},
+		Pass: []string{
+			"if (a) {} while (b) {} do {} while (c); switch (d) {}",
+			"x = (a, b)",
+			"const a = (b + c) * d",
+			"const el = (<div />)",
+			"function f() { return (a && b) }",
+			"const f = () => (a || b)",
+			"(1).toString()",
+			"('use strict')",
+			"({ a } = b);",
+			"(function () {});",
+			"(async () => {})();",
+			"(class {});",
+			"const f = () => ({})",
+			"f(a + (/* why */ b))",
+			"function f() { return (\n\tx\n) }",
+		},
+	}
+	testCase.Run(t)
+
+	expressions := &TestCase{
+		Name: "no-extra-parens-expressions.js",
+		Rule: js_rules.NoExtraParens(&js_rules.NoExtraParensOptions{IncludeExpressions: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "const f = () => (a || b)",
+				Expected: []ExpectedIssue{{Message: "Unnecessary parentheses around expression"}},
+			},
+			{
+				Code:     "function f() { return (a && b) }",
+				Expected: []ExpectedIssue{{Message: "Unnecessary parentheses around expression"}},
+			},
+		},
+		Pass: []string{
+			"x = (a, b)",
+			"const f = () => ({ a: 1 })",
+			"function f() { return (<div />) }",
+			"const a = (b + c) * d",
+		},
+	}
+	expressions.Run(t)
+
+	rule := js_rules.NoExtraParens(nil)
+	assert.Equal(t, "function f(x) { return x; }", fixedSource(t, rule, "function f(x) { return (x); }"))
+	assert.Equal(t, "typeof x === 'string'", fixedSource(t, rule, "typeof(x) === 'string'"))
+	assert.Equal(t, "if (a) {}", fixedSource(t, rule, "if ((a)) {}"))
+	assert.Equal(t, "foo();", fixedSource(t, rule, "(foo());"))
+	assert.Equal(t, "const a = b + c;", fixedSource(t, rule, "const a = (b + c);"))
+}
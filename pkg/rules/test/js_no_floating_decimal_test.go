@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoFloatingDecimal(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-floating-decimal.js",
+		Rule: js_rules.NoFloatingDecimal(),
+		Raise: []ShouldRaise{
+			{
+				Code: "const half = .5",
+				Expected: []ExpectedIssue{{
+					Message: "Number '.5' has a leading decimal point. Use '0.5' instead",
+					Start:   &sitter.Point{Row: 0, Column: 13},
+					End:     &sitter.Point{Row: 0, Column: 15},
+				}},
+			},
+			{
+				Code: "x = 2. + 2.e5 - .5E-3 + 1_000.",
+				Expected: []ExpectedIssue{
+					{Message: "Number '2.' has a trailing decimal point. Use '2.0' instead"},
+					{Message: "Number '2.e5' has a trailing decimal point. Use '2.0e5' instead"},
+					{Message: "Number '.5E-3' has a leading decimal point. Use '0.5E-3' instead"},
+					{Message: "Number '1_000.' has a trailing decimal point. Use '1_000.0' instead"},
+				},
+			},
+		},
+		Pass: []string{
+			"x = 0.5 + 2.0 + 2 + 1e5 + 2.5e-3",
+			"x = 0x1E + 0b1 + 0o7 + 10n",
+			"x = 2.5.toFixed(1)",
+		},
+	}
+	testCase.Run(t)
+
+	rule := js_rules.NoFloatingDecimal()
+	assert.Equal(t, "x = 0.5 + 2.0.toString()", fixedSource(t, rule, "x = .5 + 2..toString()"))
+}
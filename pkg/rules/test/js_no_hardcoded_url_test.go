@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoHardcodedUrl(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-hardcoded-url.js",
+		Rule: js_rules.NoHardcodedUrl(&js_rules.NoHardcodedUrlOptions{Allow: []string{"internal.dev"}}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `fetch("https://api.prod.acme.io/v1/users")`,
+				Expected: []ExpectedIssue{{Message: "Hardcoded URL 'https://api.prod.acme.io/v1/users'. Consider reading it from configuration."}},
+			},
+			{
+				Code:     "const db = `postgres://admin@10.0.3.12:5432/${name}`",
+				Expected: []ExpectedIssue{{Message: "Hardcoded URL 'postgres://admin@10.0.3.12:5432/${name}'. Consider reading it from configuration."}},
+			},
+			{
+				Code:     `const host = "192.168.1.20"`,
+				Expected: []ExpectedIssue{{Message: "Hardcoded IP address '192.168.1.20'. Consider reading it from configuration."}},
+			},
+		},
+		Pass: []string{
+			`fetch("http://localhost:3000/api")`,
+			`const docs = "https://www.example.com/docs"`,
+			`const api = "https://api.internal.dev"`,
+			`const addr = "127.0.0.1"`,
+			`const version = "999.1.2.3"`,
+			`import x from "https://deno.land/std/mod.ts"`,
+			`const greeting = "hello, world"`,
+			`fetch("https://api.prod.acme.io") // onelint-disable-line js-no-hardcoded-url`,
+			"// onelint-disable-next-line\nconst host = \"192.168.1.20\"",
+		},
+	}
+	testCase.Run(t)
+
+	parsed, err := one.ParseString(`fetch("https://api.prod.acme.io")`, one.LangJs)
+	require.NoError(t, err)
+	issues := one.NewAnalyzer(parsed, []one.Rule{js_rules.NoHardcodedUrl(nil)}).Analyze()
+	require.Equal(t, 1, len(issues))
+	assert.Equal(t, one.SeverityInfo, issues[0].Severity)
+}
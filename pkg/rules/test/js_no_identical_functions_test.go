@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoIdenticalFunctions(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-identical-functions.js",
+		Rule: js_rules.NoIdenticalFunctions(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `function total(items) {
+	let sum = 0
+	for (const item of items) sum += item.price
+	return sum
+}
+
+function sumPrices(xs) {
+	// same thing, different names
+	let s = 0
+	for (const x of xs) s += x.price
+	return s
+}`,
+				Expected: []ExpectedIssue{{
+					Message: "This function is identical to 'total' on line 1",
+					Start:   &sitter.Point{Row: 6, Column: 9},
+					End:     &sitter.Point{Row: 6, Column: 18},
+				}},
+			},
+			{
+				Code: `const a = (x) => {
+	return x * 2
+}
+const b = (y) => {
+	return y * 2
+}
+const c = function (z) {
+	return z * 2
+}`,
+				Expected: []ExpectedIssue{
+					{Message: "This function is identical to 'a' on line 1"},
+					{Message: "This function is identical to 'a' on line 1"},
+				},
+			},
+		},
+		Pass: []string{
+			// different operators
+			"function f(a, b) {\n\treturn a + b\n}\nfunction g(a, b) {\n\treturn a - b\n}",
+			// different literals
+			"function f(a) {\n\treturn a + 1\n}\nfunction g(a) {\n\treturn a + 2\n}",
+			// `a + a` is not `a + b`
+			"function f(a, b) {\n\treturn a + a\n}\nfunction g(a, b) {\n\treturn a + b\n}",
+			// too short to be flagged
+			"function f() { return 1 }\nfunction g() { return 1 }",
+		},
+	}
+	testCase.Run(t)
+	// two instances in one analyzer collect functions separately,
+	// so a function is never compared with itself.
+	parsed, err := one.ParseString("function f() {\n\treturn 1\n}", one.LangJs)
+	require.NoError(t, err)
+	issues := one.NewAnalyzer(parsed, []one.Rule{
+		js_rules.NoIdenticalFunctions(nil),
+		js_rules.NoIdenticalFunctions(&js_rules.NoIdenticalFunctionsOptions{MinLines: 1}),
+	}).Analyze()
+	assert.Empty(t, issues)
+}
@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoMagicNumbers(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-magic-numbers.ts",
+		Rule: js_rules.NoMagicNumbers(nil),
+		Raise: []ShouldRaise{
+			{
+				Code:     `let timeout = 3000`,
+				Expected: []ExpectedIssue{{Message: "Magic number '3000'. Extract it into a named constant"}},
+			},
+			{
+				Code: `if (x > -5) { y = x * 0x10 }`,
+				Expected: []ExpectedIssue{
+					{Message: "Magic number '-5'. Extract it into a named constant"},
+					{Message: "Magic number '0x10'. Extract it into a named constant"},
+				},
+			},
+			{
+				Code:     `arr[3]`,
+				Expected: []ExpectedIssue{{Message: "Magic number '3'. Extract it into a named constant"}},
+			},
+		},
+		Pass: []string{
+			`const TIMEOUT = 3000`,
+			`for (let i = 0; i < n; i += 1) { x[i] = -1 * 2 }`,
+			`type Level = 3 | 4`,
+			`enum E { A = 5 }`,
+		},
+	}
+	testCase.Run(t)
+
+	withOptions := &TestCase{
+		Name: "no-magic-numbers.js",
+		Rule: js_rules.NoMagicNumbers(&js_rules.NoMagicNumbersOptions{
+			Allowed:            []float64{0, 100},
+			IgnoreArrayIndexes: true,
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `let pct = 100 * x / 1`,
+				Expected: []ExpectedIssue{{Message: "Magic number '1'. Extract it into a named constant"}},
+			},
+		},
+		Pass: []string{`arr[3]`},
+	}
+	withOptions.Run(t)
+}
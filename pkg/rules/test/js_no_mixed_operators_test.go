@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoMixedOperators(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-mixed-operators.js",
+		Rule: js_rules.NoMixedOperators(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "x = a && b || c",
+				Expected: []ExpectedIssue{{
+					Message: "Unexpected mix of '&&' and '||'. Use parentheses to make the order of evaluation clear",
+					Start:   &sitter.Point{Row: 0, Column: 4},
+					End:     &sitter.Point{Row: 0, Column: 15},
+				}},
+			},
+			{
+				Code: `
+				x = a + b << c
+				y = a || b && c && d
+				if (flags & MASK == 0) {}`,
+				Expected: []ExpectedIssue{
+					{Message: "Unexpected mix of '+' and '<<'. Use parentheses to make the order of evaluation clear"},
+					{Message: "Unexpected mix of '||' and '&&'. Use parentheses to make the order of evaluation clear"},
+					{Message: "Unexpected mix of '&' and '=='. Use parentheses to make the order of evaluation clear"},
+				},
+			},
+		},
+		Pass: []string{
+			"x = (a && b) || c",
+			"x = a || b || c",
+			"x = a + b - c",
+			"x = a + b * c",
+			"x = a && b + c",
+			"x = (a + b) << c",
+			"x = a == b != c",
+		},
+	}
+	testCase.Run(t)
+
+	custom := &TestCase{
+		Name: "no-mixed-operators-custom.js",
+		Rule: js_rules.NoMixedOperators(&js_rules.NoMixedOperatorsOptions{
+			Groups:              [][]string{{"+", "-", "*", "/"}, {"==", "!="}},
+			CheckSamePrecedence: true,
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code: "x = a + b * c; y = a - b + c; z = a == b != c",
+				Expected: []ExpectedIssue{
+					{Message: "Unexpected mix of '+' and '*'. Use parentheses to make the order of evaluation clear"},
+					{Message: "Unexpected mix of '-' and '+'. Use parentheses to make the order of evaluation clear"},
+					{Message: "Unexpected mix of '==' and '!='. Use parentheses to make the order of evaluation clear"},
+				},
+			},
+		},
+		Pass: []string{"x = a && b || c", "x = a + (b * c)"},
+	}
+	custom.Run(t)
+}
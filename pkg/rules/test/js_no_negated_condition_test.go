@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+const negatedConditionMessage = "Unexpected negated condition. Consider swapping the branches and removing the negation"
+
+func TestNoNegatedCondition(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-negated-condition.js",
+		Rule: js_rules.NoNegatedCondition(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "if (!ready) { wait() } else { go() }",
+				Expected: []ExpectedIssue{{
+					Message: negatedConditionMessage,
+					Start:   &sitter.Point{Row: 0, Column: 4},
+					End:     &sitter.Point{Row: 0, Column: 10},
+				}},
+			},
+			{
+				Code:     "if (a !== b) foo(); else bar()",
+				Expected: []ExpectedIssue{{Message: negatedConditionMessage}},
+			},
+		},
+		Pass: []string{
+			"if (!x) { a() }",
+			"if (!x) { a() } else if (y) { b() }",
+			"if (!!x) { a() } else { b() }",
+			"if (a === b) { a() } else { b() }",
+			"const y = !a ? 1 : 2",
+		},
+	}
+	testCase.Run(t)
+
+	custom := &TestCase{
+		Name: "no-negated-condition-custom.js",
+		Rule: js_rules.NoNegatedCondition(&js_rules.NoNegatedConditionOptions{
+			IncludeTernaries: true,
+			IgnoreInequality: true,
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "const y = !a ? 1 : 2",
+				Expected: []ExpectedIssue{{Message: negatedConditionMessage}},
+			},
+		},
+		Pass: []string{"if (a != b) { a() } else { b() }"},
+	}
+	custom.Run(t)
+
+	rule := js_rules.NoNegatedCondition(nil)
+	assert.Equal(t,
+		"if (ready) { go() } else { wait() }",
+		fixedSource(t, rule, "if (!ready) { wait() } else { go() }"),
+	)
+	assert.Equal(t,
+		"if (a && b) {\n\tyes()\n} else {\n\tno()\n}",
+		fixedSource(t, rule, "if (!(a && b)) {\n\tno()\n} else {\n\tyes()\n}"),
+	)
+	assert.Equal(t,
+		"if (a == b) { same() } else { different() }",
+		fixedSource(t, rule, "if (a != b) { different() } else { same() }"),
+	)
+	// statements without braces are not swapped
+	assert.Equal(t, "if (!x) a()\nelse b()", fixedSource(t, rule, "if (!x) a()\nelse b()"))
+
+	ternary := js_rules.NoNegatedCondition(&js_rules.NoNegatedConditionOptions{IncludeTernaries: true})
+	assert.Equal(t, "const y = a ? 2 : 1", fixedSource(t, ternary, "const y = !a ? 1 : 2"))
+}
@@ -0,0 +1,47 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestJsNoNestedTernary(t *testing.T) {
+	message := "Do not nest ternary expressions. Use an if-else statement instead"
+	testCase := &TestCase{
+		Name: "no-nested-ternary.js",
+		Rule: js_rules.NoNestedTernary(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `x = a ? b : c ? d : e`,
+				Expected: []ExpectedIssue{{
+					Message: message,
+					Start:   &sitter.Point{Row: 0, Column: 12},
+				}},
+			},
+			{
+				Code:     `x = a ? (b ? c : d) : e ? f : g ? h : i`,
+				Expected: []ExpectedIssue{{Message: message}, {Message: message}},
+			},
+		},
+		Pass: []string{
+			`x = a ? b : c`,
+			`x = (a ? b : c) ? d : e`,
+		},
+	}
+	testCase.Run(t)
+
+	configured := &TestCase{
+		Name: "no-nested-ternary.js",
+		Rule: js_rules.NoNestedTernary(&js_rules.NoNestedTernaryOptions{MaxDepth: 1}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `x = a ? b : c ? d : e ? f : g`,
+				Expected: []ExpectedIssue{{Message: message, Start: &sitter.Point{Row: 0, Column: 20}}},
+			},
+		},
+		Pass: []string{`x = a ? b : c ? d : e`},
+	}
+	configured.Run(t)
+}
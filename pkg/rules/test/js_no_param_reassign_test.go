@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoParamReassign(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-param-reassign.js",
+		Rule: js_rules.NoParamReassign(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `function f(a) { a = 1 }`,
+				Expected: []ExpectedIssue{{
+					Message: "Assignment to function parameter 'a'",
+					Start:   &sitter.Point{Row: 0, Column: 16},
+					End:     &sitter.Point{Row: 0, Column: 21},
+				}},
+			},
+			{
+				Code: `const f = (a, { b }) => { a += 1; b++ }`,
+				Expected: []ExpectedIssue{
+					{Message: "Assignment to function parameter 'a'"},
+					{Message: "Assignment to function parameter 'b'"},
+				},
+			},
+			{
+				Code:     `const f = x => { for (x in obj) {} }`,
+				Expected: []ExpectedIssue{{Message: "Assignment to function parameter 'x'"}},
+			},
+		},
+		Pass: []string{
+			`function f(a) { let b = a; b = 2 }`,
+			`function f(a) { a.x = 1; a[0]++ }`,
+			`function f(a) { function g(a) {} }`,
+			`let a = 1; a = 2`,
+			// the loop declares a new `x` that shadows the parameter
+			`function f(x) { for (const x of xs) {} }`,
+			`function f(x) { for (let [x, { y }] in obj) { x = y } }`,
+		},
+	}
+	testCase.Run(t)
+
+	propsCase := &TestCase{
+		Name: "no-param-reassign-props.js",
+		Rule: js_rules.NoParamReassign(&js_rules.NoParamReassignOptions{Props: true}),
+		Raise: []ShouldRaise{
+			{
+				Code: `function f(a) { a.x = 1; a.y.z += 1; a[0]++ }`,
+				Expected: []ExpectedIssue{
+					{Message: "Assignment to function parameter 'a'"},
+					{Message: "Assignment to function parameter 'a'"},
+					{Message: "Assignment to function parameter 'a'"},
+				},
+			},
+		},
+		Pass: []string{
+			`function f(a) { b.x = a.x; a.f() }`,
+		},
+	}
+	propsCase.Run(t)
+}
+
+func TestNoParamReassignRelatedLocation(t *testing.T) {
+	code := `function f(x) { x = 1 }`
+	parseResult, err := one.Parse("file.js", []byte(code), one.LangJs, one.LangJs.Grammar())
+	require.NoError(t, err)
+
+	issues := one.NewAnalyzer(parseResult, []one.Rule{js_rules.NoParamReassign(nil)}).Analyze()
+	require.Equal(t, 1, len(issues))
+	require.Equal(t, 1, len(issues[0].Related))
+	assert.Equal(t, uint32(11), issues[0].Related[0].Range.StartPoint.Column)
+}
@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoPropsMutation(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-props-mutation.jsx",
+		Rule: js_rules.NoPropsMutation(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "class A extends Component { render() { this.props.x = 1; return null } }",
+				Expected: []ExpectedIssue{{
+					Message: "Props are read-only. Do not mutate 'this.props.x'",
+					Start:   &sitter.Point{Row: 0, Column: 39},
+					End:     &sitter.Point{Row: 0, Column: 55},
+				}},
+			},
+			{
+				Code: `function List(props) {
+	props.items.push(extra)
+	props.items.sort()
+	props.count++
+	delete props.user.name
+	props["title"] += "!"
+	return <ul />
+}`,
+				Expected: []ExpectedIssue{
+					{Message: "Props are read-only. Do not mutate 'props.items'"},
+					{Message: "Props are read-only. Do not mutate 'props.items'"},
+					{Message: "Props are read-only. Do not mutate 'props.count'"},
+					{Message: "Props are read-only. Do not mutate 'props.user.name'"},
+					{Message: "Props are read-only. Do not mutate 'props[\"title\"]'"},
+				},
+			},
+		},
+		Pass: []string{
+			"function List(props) { const items = [...props.items]; items.push(1); props.onChange(items) }",
+			"function List(props) { props = { ...props } }",
+			"const props = {}; props.x = 1",
+			"this.state.x = 1",
+			"function f(props) { props.items.map(x => x) }",
+		},
+	}
+	testCase.Run(t)
+
+	custom := &TestCase{
+		Name: "no-props-mutation-custom.jsx",
+		Rule: js_rules.NoPropsMutation(&js_rules.NoPropsMutationOptions{Methods: []string{"set"}}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "function A(props) { props.cache.set(k, v); props.list.push(v) }",
+				Expected: []ExpectedIssue{{Message: "Props are read-only. Do not mutate 'props.cache'"}, {Message: "Props are read-only. Do not mutate 'props.list'"}},
+			},
+		},
+	}
+	custom.Run(t)
+}
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoRedundantBoolean(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-redundant-boolean.js",
+		Rule: js_rules.NoRedundantBoolean(),
+		Raise: []ShouldRaise{
+			{
+				Code:     `if (x === true) {}`,
+				Expected: []ExpectedIssue{{Message: "Unnecessary comparison to 'true'. If the value is always a boolean, simplify to 'x'"}},
+			},
+			{
+				Code:     `const y = false == a.b`,
+				Expected: []ExpectedIssue{{Message: "'==' converts both sides to numbers before comparing to 'false'. Use '!a.b' to check if the value is truthy"}},
+			},
+			{
+				Code:     `while (a + b !== true) {}`,
+				Expected: []ExpectedIssue{{Message: "Unnecessary comparison to 'true'. If the value is always a boolean, simplify to '!(a + b)'"}},
+			},
+			{
+				Code:     `if (n === true) {}`,
+				Expected: []ExpectedIssue{{Message: "Unnecessary comparison to 'true'. If the value is always a boolean, simplify to 'n'"}},
+			},
+			{
+				Code:     `if (x != true) {}`,
+				Expected: []ExpectedIssue{{Message: "'!=' converts both sides to numbers before comparing to 'true'. Use '!x' to check if the value is truthy"}},
+			},
+		},
+		Pass: []string{
+			`if (x === y) {}`,
+			`if (x === "true") {}`,
+			`const y = x && true`,
+		},
+	}
+	testCase.Run(t)
+
+	// the comparison isn't the same as the operand when it isn't a boolean,
+	// even in a condition: `if (1 === true)` doesn't run, but `if (1)` does.
+	for _, code := range []string{
+		`if (n === true) {}`,
+		`if (ok() !== false) {}`,
+		`while (a && b === false) {}`,
+	} {
+		assert.Equal(t, code, fixedSource(t, js_rules.NoRedundantBoolean(), code))
+	}
+}
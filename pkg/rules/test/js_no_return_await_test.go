@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoReturnAwait(t *testing.T) {
+	message := "Redundant use of 'await' on a return value"
+	testCase := &TestCase{
+		Name: "no-return-await.js",
+		Rule: js_rules.NoReturnAwait(),
+		Raise: []ShouldRaise{
+			{
+				Code: `async function f() { return await g() }`,
+				Expected: []ExpectedIssue{{
+					Message: message,
+					Start:   &sitter.Point{Row: 0, Column: 28},
+					End:     &sitter.Point{Row: 0, Column: 33},
+				}},
+			},
+			{
+				Code:     `async function f() { return (await g()) }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+			{
+				Code:     `async function f() { try { x() } catch (e) { return await g() } }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+			{
+				Code:     `async function f() { try { x() } finally { return await g() } }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+			{
+				Code:     `async function f() { try { return items.map(async (x) => { return await g(x) }) } catch {} }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+		},
+		Pass: []string{
+			`async function f() { return g() }`,
+			`async function f() { try { return await g() } catch (e) {} }`,
+			`async function f() { try { if (x) { return await g() } } finally {} }`,
+			`async function f() { try { x() } catch (e) { return await g() } finally {} }`,
+			`async function f() { const x = await g(); return x }`,
+		},
+	}
+	testCase.Run(t)
+
+	assert.Equal(t, "async function f() { return g() }", fixedSource(t, js_rules.NoReturnAwait(), "async function f() { return await g() }"))
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoSelfAssignment(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-self-assignment.js",
+		Rule: js_rules.NoSelfAssignment(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "let x = 1; x = x",
+				Expected: []ExpectedIssue{{
+					Message: "'x' is assigned to itself",
+					Start:   &sitter.Point{Row: 0, Column: 11},
+					End:     &sitter.Point{Row: 0, Column: 16},
+				}},
+			},
+			{
+				Code: "this.a = this.a; obj.x = obj. /* x */ x; a[0] = a[ 0 ]; b['k'] = (b['k'])",
+				Expected: []ExpectedIssue{
+					{Message: "'this.a' is assigned to itself"},
+					{Message: "'obj.x' is assigned to itself"},
+					{Message: "'a[0]' is assigned to itself"},
+					{Message: "'b['k']' is assigned to itself"},
+				},
+			},
+			{
+				Code:     "x ||= x; y ??= y",
+				Expected: []ExpectedIssue{{Message: "'x' is assigned to itself"}, {Message: "'y' is assigned to itself"}},
+			},
+		},
+		Pass: []string{
+			"x = y",
+			"x += x",
+			"a.b = a.c",
+			"a['x y'] = a['xy']",
+			"a[i++] = a[i++]",
+			"a[f()] = a[f()]",
+			"[a, b] = [b, a]",
+		},
+	}
+	testCase.Run(t)
+
+	allowProperties := &TestCase{
+		Name: "no-self-assignment-properties.js",
+		Rule: js_rules.NoSelfAssignment(&js_rules.NoSelfAssignmentOptions{AllowProperties: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "x = x; el.value = el.value",
+				Expected: []ExpectedIssue{{Message: "'x' is assigned to itself"}},
+			},
+		},
+	}
+	allowProperties.Run(t)
+}
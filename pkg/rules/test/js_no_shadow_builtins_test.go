@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestJsNoShadowBuiltins(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-shadow-builtins.js",
+		Rule: js_rules.NoShadowBuiltins(nil),
+		Raise: []ShouldRaise{
+			{
+				Code:     `function f(Array) { return Array }`,
+				Expected: []ExpectedIssue{{Message: "'Array' shadows a built-in global"}},
+			},
+			{
+				Code: `
+				function f() {
+					const Object = 1
+					if (x) { let { Map } = y }
+				}`,
+				Expected: []ExpectedIssue{
+					{Message: "'Object' shadows a built-in global"},
+					{Message: "'Map' shadows a built-in global"},
+				},
+			},
+		},
+		Pass: []string{
+			`const f = $ => $`,
+			`const Array = 1`,
+			`function f(arr) { return Array.isArray(arr) }`,
+		},
+	}
+	testCase.Run(t)
+
+	extended := &TestCase{
+		Name: "no-shadow-builtins.js",
+		Rule: js_rules.NoShadowBuiltins(&js_rules.NoShadowBuiltinsOptions{Builtins: []string{"$"}}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `const f = $ => $`,
+				Expected: []ExpectedIssue{{Message: "'$' shadows a built-in global"}},
+			},
+		},
+	}
+	extended.Run(t)
+}
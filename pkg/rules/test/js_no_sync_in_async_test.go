@@ -0,0 +1,75 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoSyncInAsync(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-sync-in-async.js",
+		Rule: js_rules.NoSyncInAsync(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `async function load(path) { return JSON.parse(fs.readFileSync(path)) }`,
+				Expected: []ExpectedIssue{{
+					Message: "'readFileSync' blocks the event loop inside an async function. Use the async variant instead.",
+				}},
+			},
+			{
+				Code: `const save = async () => { writeFileSync(path, data) }`,
+				Expected: []ExpectedIssue{{
+					Message: "'writeFileSync' blocks the event loop inside an async function. Use the async variant instead.",
+				}},
+			},
+			{
+				Code: `class Store { async load() { if (fs.existsSync(p)) {} } }`,
+				Expected: []ExpectedIssue{{
+					Message: "'existsSync' blocks the event loop inside an async function. Use the async variant instead.",
+				}},
+			},
+		},
+		Pass: []string{
+			`function load(path) { return fs.readFileSync(path) }`,
+			`async function load(path) { return fs.promises.readFile(path) }`,
+			`async function f() { return function () { fs.readFileSync(p) } }`,
+			`fs.readFileSync(path)`,
+		},
+	}
+	testCase.Run(t)
+
+	customCase := &TestCase{
+		Name: "no-sync-in-async-custom.js",
+		Rule: js_rules.NoSyncInAsync(&js_rules.NoSyncInAsyncOptions{Functions: []string{"globSync"}}),
+		Raise: []ShouldRaise{
+			{
+				Code: `async function f() { return glob.globSync("*.js") }`,
+				Expected: []ExpectedIssue{{
+					Message: "'globSync' blocks the event loop inside an async function. Use the async variant instead.",
+				}},
+			},
+		},
+	}
+	customCase.Run(t)
+
+	replaceCase := &TestCase{
+		Name: "no-sync-in-async-replace.js",
+		Rule: js_rules.NoSyncInAsync(&js_rules.NoSyncInAsyncOptions{
+			Functions:       []string{"readFileSync"},
+			ReplaceDefaults: true,
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code: `async function f() { return fs.readFileSync("a.txt") }`,
+				Expected: []ExpectedIssue{{
+					Message: "'readFileSync' blocks the event loop inside an async function. Use the async variant instead.",
+				}},
+			},
+		},
+		Pass: []string{
+			`async function f() { return fs.existsSync("a.txt") }`,
+		},
+	}
+	replaceCase.Run(t)
+}
@@ -0,0 +1,47 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoThrowLiteral(t *testing.T) {
+	message := "Expected an Error object to be thrown. Throwing a literal loses the stack trace."
+	testCase := &TestCase{
+		Name: "no-throw-literal.js",
+		Rule: js_rules.NoThrowLiteral(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `throw "oops"`,
+				Expected: []ExpectedIssue{{
+					Message: message,
+					Start:   &sitter.Point{Row: 0, Column: 6},
+					End:     &sitter.Point{Row: 0, Column: 12},
+				}},
+			},
+			{Code: `throw 42`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: "throw `failed: ${reason}`", Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `function f() { throw (false) }`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `throw { code: 404 }`, Expected: []ExpectedIssue{{Message: message}}},
+		},
+		Pass: []string{
+			`throw new Error("oops")`,
+			`throw err`,
+			`throw makeError("oops")`,
+			`throw this.error`,
+		},
+	}
+	testCase.Run(t)
+
+	allowObjectsCase := &TestCase{
+		Name: "no-throw-literal-objects.js",
+		Rule: js_rules.NoThrowLiteral(&js_rules.NoThrowLiteralOptions{AllowObjects: true}),
+		Raise: []ShouldRaise{
+			{Code: `throw "oops"`, Expected: []ExpectedIssue{{Message: message}}},
+		},
+		Pass: []string{`throw { code: 404 }`},
+	}
+	allowObjectsCase.Run(t)
+}
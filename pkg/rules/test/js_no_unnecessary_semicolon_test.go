@@ -0,0 +1,51 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoUnnecessarySemicolon(t *testing.T) {
+	message := "Unnecessary semicolon"
+	testCase := &TestCase{
+		Name: "no-unnecessary-semicolon.js",
+		Rule: js_rules.NoUnnecessarySemicolon(),
+		Raise: []ShouldRaise{
+			{
+				Code: `foo();;`,
+				Expected: []ExpectedIssue{{
+					Message: message,
+					Start:   &sitter.Point{Row: 0, Column: 6},
+					End:     &sitter.Point{Row: 0, Column: 7},
+				}},
+			},
+			{
+				Code:     `;;`,
+				Expected: []ExpectedIssue{{Message: message}, {Message: message}},
+			},
+			{
+				Code:     `function f() { return 1;; }`,
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+			{
+				Code:     `switch (x) { case 1: ; break; default: ; }`,
+				Expected: []ExpectedIssue{{Message: message}, {Message: message}},
+			},
+		},
+		Pass: []string{
+			`for(;;);`,
+			`while (x);`,
+			`if (x) ; else ;`,
+			`label: ;`,
+			`foo(); bar();`,
+		},
+	}
+	testCase.Run(t)
+
+	rule := js_rules.NoUnnecessarySemicolon()
+	assert.Equal(t, "foo();", fixedSource(t, rule, "foo();;"))
+	assert.Equal(t, "for(;;);", fixedSource(t, rule, "for(;;);;"))
+}
@@ -0,0 +1,69 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestJsNoUnreachable(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-unreachable.js",
+		Rule: js_rules.NoUnreachable(),
+		Raise: []ShouldRaise{
+			{
+				Code: `function f() {
+	return 1
+	foo()
+	bar()
+}`,
+				Expected: []ExpectedIssue{{
+					Message: "Unreachable code",
+					Start:   &sitter.Point{Row: 2, Column: 1},
+				}},
+			},
+			{
+				Code: `
+				for (const x of xs) {
+					if (x) { continue; x++ }
+					break
+					// comment
+					log(x)
+				}`,
+				Expected: []ExpectedIssue{{Message: "Unreachable code"}, {Message: "Unreachable code"}},
+			},
+			{
+				Code: `
+				switch (x) {
+					case 1:
+						throw new Error()
+						foo()
+				}`,
+				Expected: []ExpectedIssue{{Message: "Unreachable code"}},
+			},
+		},
+		Pass: []string{
+			`
+			function f() {
+				return g()
+				function g() { return 1 }
+			}`,
+			`
+			function f(x) {
+				if (x) return 1
+				return 2
+			}`,
+			`
+			switch (x) {
+				case 1:
+					foo()
+					break
+				case 2:
+					bar()
+			}`,
+		},
+	}
+
+	testCase.Run(t)
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoUnusedParams(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-unused-params.js",
+		Rule: js_rules.NoUnusedParams(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `function f(a, b) { return a }`,
+				Expected: []ExpectedIssue{{
+					Message: "Parameter 'b' is never used",
+					Start:   &sitter.Point{Row: 0, Column: 14},
+					End:     &sitter.Point{Row: 0, Column: 15},
+				}},
+			},
+			{
+				Code:     `const f = (a, b) => b`,
+				Expected: []ExpectedIssue{{Message: "Parameter 'a' is never used"}},
+			},
+			{
+				Code:     `const f = x => 1`,
+				Expected: []ExpectedIssue{{Message: "Parameter 'x' is never used"}},
+			},
+			{
+				Code:     `function f({ a, b }, c = 1) {}`,
+				Expected: []ExpectedIssue{{Message: "Parameter '{ a, b }' is never used"}, {Message: "Parameter 'c' is never used"}},
+			},
+			{
+				Code:     `class A { m(_x) {} }`,
+				Expected: []ExpectedIssue{{Message: "Parameter '_x' is never used"}},
+			},
+		},
+		Pass: []string{
+			`function f(a, b) { return a + b }`,
+			`function f({ a, b }) { return b }`,
+			`function f(...rest) { return rest }`,
+			`function f(a) { return () => a }`,
+			`class A { constructor(private x) {} }`,
+		},
+	}
+	testCase.Run(t)
+
+	ignoreUnderscored := &TestCase{
+		Name: "no-unused-params-underscored.js",
+		Rule: js_rules.NoUnusedParams(&js_rules.NoUnusedParamsOptions{IgnoreUnderscored: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `function f(_a, b) {}`,
+				Expected: []ExpectedIssue{{Message: "Parameter 'b' is never used"}},
+			},
+		},
+		Pass: []string{
+			`function f(_a, _b) {}`,
+		},
+	}
+	ignoreUnderscored.Run(t)
+
+	rule := js_rules.NoUnusedParams(nil)
+	assert.Equal(t, "function f(a) { return a }", fixedSource(t, rule, "function f(a, b, c) { return a }"))
+	assert.Equal(t, "function f() {}", fixedSource(t, rule, "function f(a) {}"))
+	// `a` can't be removed without changing which argument `b` receives.
+	assert.Equal(t, "function f(a, b) { return b }", fixedSource(t, rule, "function f(a, b, c) { return b }"))
+	assert.Equal(t, "class A { set v(x) {} }", fixedSource(t, rule, "class A { set v(x) {} }"))
+	assert.Equal(t, "const f = () => 1", fixedSource(t, rule, "const f = x => 1"))
+	assert.Equal(t, "const g = async () => 1", fixedSource(t, rule, "const g = async x => 1"))
+	assert.Equal(t, "const h = () => 1", fixedSource(t, rule, "const h = (x) => 1"))
+	// comments aren't parameters
+	assert.Equal(t, "function f(a) { return a }", fixedSource(t, rule, "function f(a, /* b */ b) { return a }"))
+	assert.Equal(t, "function f(a /* b */) { return a }", fixedSource(t, rule, "function f(a, b /* b */) { return a }"))
+}
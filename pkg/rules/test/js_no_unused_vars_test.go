@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoUnusedVars(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-unused-vars.js",
+		Rule: js_rules.NoUnusedVars(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "const x = 1",
+				Expected: []ExpectedIssue{{
+					Message: "'x' is declared but never used",
+					Start:   &sitter.Point{Row: 0, Column: 6},
+					End:     &sitter.Point{Row: 0, Column: 7},
+				}},
+			},
+			{
+				Code: `
+				import { a, b as c } from "mod"
+				let count = 0
+				count = 1
+				count++
+				function recurse(n) { return recurse(n - 1) }
+				const { p, q: [r] } = obj
+				console.log(a, p)`,
+				Expected: []ExpectedIssue{
+					{Message: "'c' is imported but never used"},
+					{Message: "'count' is assigned a value but never read"},
+					{Message: "Function 'recurse' is declared but never used"},
+					{Message: "'r' is declared but never used"},
+				},
+			},
+			{
+				// only the parameters after the last used one are reported
+				Code: `
+				const f = (a, b, c) => b
+				function g(x) { const x2 = 1; return 1 }
+				g(); f()`,
+				Expected: []ExpectedIssue{
+					{Message: "Parameter 'c' is never used"},
+					{Message: "Parameter 'x' is never used"},
+					{Message: "'x2' is declared but never used"},
+				},
+			},
+			{
+				// exporting a function doesn't export what's declared inside it
+				Code: `
+				export function f(a, b) { const unused = 1; return a }
+				export default function (c) { let local }
+				export const g = (d) => { const inner = 2 }`,
+				Expected: []ExpectedIssue{
+					{Message: "Parameter 'b' is never used"},
+					{Message: "'unused' is declared but never used"},
+					{Message: "Parameter 'c' is never used"},
+					{Message: "'local' is declared but never used"},
+					{Message: "Parameter 'd' is never used"},
+					{Message: "'inner' is declared but never used"},
+				},
+			},
+			{
+				// the inner `x` shadows the outer one
+				Code: `
+				const x = 1
+				function f() { const x = 2; return x }
+				f()`,
+				Expected: []ExpectedIssue{
+					{Message: "'x' is declared but never used", Start: &sitter.Point{Row: 1, Column: 10}},
+				},
+			},
+		},
+		Pass: []string{
+			"const x = 1; console.log(x)",
+			"f(); function f() {}",
+			"export const x = 1; export function f() {}",
+			"export var a = 1, [b] = xs; export function* g() {}",
+			"const x = 1; export { x }",
+			"const _unused = 1; [1].map((_x, i) => i)",
+			"const { a, ...rest } = obj; use(rest)",
+			"class A { set value(v) {} }",
+			"const Foo = () => null; const el = <Foo />; render(el)",
+			"let i = 0; i = i + 1; use(i)",
+			// imports used only as types are not reported
+			`import { Props } from "mod"; let p: Props; use(p)`,
+		},
+	}
+	testCase.Run(t)
+
+	kinds := &TestCase{
+		Name: "no-unused-vars-kinds.ts",
+		Rule: js_rules.NoUnusedVars(&js_rules.NoUnusedVarsOptions{IgnoreParams: true, IgnoreImports: true}),
+		Raise: []ShouldRaise{
+			{
+				Code: `
+				import { Unused } from "mod"
+				const _x = 1
+				function f(a: number) {}`,
+				Expected: []ExpectedIssue{
+					{Message: "'_x' is declared but never used"},
+					{Message: "Function 'f' is declared but never used"},
+				},
+			},
+			{
+				// only imports can be used as types, a value with the same name is still unused.
+				Code: `const Props = 1; let p: Props; use(p)`,
+				Expected: []ExpectedIssue{
+					{Message: "'Props' is declared but never used"},
+				},
+			},
+		},
+	}
+	kinds.Run(t)
+}
@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoUselessRename(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-useless-rename.js",
+		Rule: js_rules.NoUselessRename(),
+		Raise: []ShouldRaise{
+			{
+				Code: `import { foo as foo, bar } from "lib"`,
+				Expected: []ExpectedIssue{{
+					Message: "'foo' is renamed to itself",
+					Start:   &sitter.Point{Row: 0, Column: 9},
+					End:     &sitter.Point{Row: 0, Column: 19},
+				}},
+			},
+			{
+				Code:     `const baz = 1; export { baz as baz }`,
+				Expected: []ExpectedIssue{{Message: "'baz' is renamed to itself"}},
+			},
+			{
+				Code: `const { bar: bar, inner: { x: x } } = obj; function f({ a: a = 1 }) {}; ({ c: c } = o)`,
+				Expected: []ExpectedIssue{
+					{Message: "'bar' is renamed to itself"},
+					{Message: "'x' is renamed to itself"},
+					{Message: "'a' is renamed to itself"},
+					{Message: "'c' is renamed to itself"},
+				},
+			},
+		},
+		Pass: []string{
+			`import { foo as bar } from "lib"`,
+			`import foo from "lib"`,
+			`export { baz as qux }`,
+			`const { bar: baz } = obj`,
+			`const { "bar": bar } = obj`,
+			`const { [key]: key } = obj`,
+			`const o = { a: a }`,
+		},
+	}
+	testCase.Run(t)
+
+	rule := js_rules.NoUselessRename()
+	assert.Equal(t, `import { foo, bar } from "lib"`, fixedSource(t, rule, `import { foo as foo, bar } from "lib"`))
+	assert.Equal(t, `export { baz }`, fixedSource(t, rule, `export { baz as baz }`))
+	assert.Equal(t, `const { bar, a = 1 } = obj`, fixedSource(t, rule, `const { bar: bar, a: a = 1 } = obj`))
+}
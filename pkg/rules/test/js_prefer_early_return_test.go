@@ -0,0 +1,63 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestPreferEarlyReturn(t *testing.T) {
+	testCase := &TestCase{
+		Name: "prefer-early-return.js",
+		Rule: js_rules.PreferEarlyReturn(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `function save(user) {
+	// only valid users are saved
+	if (user.isValid()) {
+		const record = toRecord(user)
+		db.insert(record)
+		log("saved", user.id)
+	}
+}`,
+				Expected: []ExpectedIssue{{
+					Message: "This 'if' wraps the entire function body. Consider inverting it into an early return",
+					Start:   &sitter.Point{Row: 2, Column: 1},
+					End:     &sitter.Point{Row: 2, Column: 20},
+				}},
+			},
+			{
+				Code: `const f = async () => { if (ready) { a(); b(); c(); d() } }`,
+				Expected: []ExpectedIssue{{
+					Message: "This 'if' wraps the entire function body. Consider inverting it into an early return",
+				}},
+			},
+		},
+		Pass: []string{
+			// too few statements
+			"function f() { if (x) { a(); b() } }",
+			// has an else branch
+			"function f() { if (x) { a(); b(); c() } else { d() } }",
+			// more than one statement in the body
+			"function f() { setup(); if (x) { a(); b(); c() } }",
+			// not a function body
+			"if (x) { a(); b(); c() }",
+		},
+	}
+	testCase.Run(t)
+
+	strict := &TestCase{
+		Name: "prefer-early-return-strict.js",
+		Rule: js_rules.PreferEarlyReturn(&js_rules.PreferEarlyReturnOptions{MinStatements: 1}),
+		Raise: []ShouldRaise{
+			{
+				Code: "class A { m() { if (x) { a() } } }",
+				Expected: []ExpectedIssue{{
+					Message: "This 'if' wraps the entire function body. Consider inverting it into an early return",
+				}},
+			},
+		},
+	}
+	strict.Run(t)
+}
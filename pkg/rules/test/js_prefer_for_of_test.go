@@ -0,0 +1,56 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestPreferForOf(t *testing.T) {
+	testCase := &TestCase{
+		Name: "prefer-for-of.js",
+		Rule: js_rules.PreferForOf(),
+		Raise: []ShouldRaise{
+			{
+				Code: "for (let i = 0; i < arr.length; i++) { f(arr[i]) }",
+				Expected: []ExpectedIssue{{
+					Message: "'i' is only used to index 'arr'. Use a for-of loop instead: `for (const item of arr)`",
+					Start:   &sitter.Point{Row: 0, Column: 0},
+					End:     &sitter.Point{Row: 0, Column: 50},
+				}},
+			},
+			{
+				Code: `
+				for (let j = 0; j < this.items.length; j += 1) {
+					const item = this.items[j]
+					total += item.price * this.items[j].count
+				}`,
+				Expected: []ExpectedIssue{
+					{Message: "'j' is only used to index 'this.items'. Use a for-of loop instead: `for (const item of this.items)`"},
+				},
+			},
+		},
+		Pass: []string{
+			// the index is used for something else
+			"for (let i = 0; i < arr.length; i++) { console.log(i, arr[i]) }",
+			"for (let i = 0; i < arr.length; i++) { f(other[i]) }",
+			"for (let i = 0; i < arr.length; i++) { f(arr[i + 1]) }",
+			// elements are written to
+			"for (let i = 0; i < arr.length; i++) { arr[i] = 0 }",
+			"for (let i = 0; i < arr.length; i++) { arr[i]++ }",
+			// the index is changed in the body
+			"for (let i = 0; i < arr.length; i++) { if (arr[i]) i++ }",
+			// not a plain loop over every element
+			"for (let i = 1; i < arr.length; i++) { f(arr[i]) }",
+			"for (let i = 0; i < arr.length; i += 2) { f(arr[i]) }",
+			"for (let i = 0; i <= arr.length; i++) { f(arr[i]) }",
+			"for (let i = 0; i < getItems().length; i++) { f(getItems()[i]) }",
+			"for (let i = 0; i < n; i++) { f(arr[i]) }",
+			"let i; for (i = 0; i < arr.length; i++) { f(arr[i]) }",
+			"for (let i = 0; i < arr.length; i++) {}",
+		},
+	}
+
+	testCase.Run(t)
+}
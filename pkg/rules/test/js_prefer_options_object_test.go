@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestPreferOptionsObject(t *testing.T) {
+	testCase := &TestCase{
+		Name: "prefer-options-object.ts",
+		Rule: js_rules.PreferOptionsObject(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "function connect(host, port, user, password) {}",
+				Expected: []ExpectedIssue{{
+					Message: "Function has 4 positional parameters, which exceeds the maximum of 3. " +
+						"Consider accepting a single options object, like `{ a, b, c }`, instead",
+					Start: &sitter.Point{Row: 0, Column: 16},
+					End:   &sitter.Point{Row: 0, Column: 44},
+				}},
+			},
+			{
+				Code: "const f = (a, [b], c = 1, d?: number, { e }) => {}",
+				Expected: []ExpectedIssue{{
+					Message: "Function has 4 positional parameters, which exceeds the maximum of 3. " +
+						"Consider accepting a single options object, like `{ a, b, c }`, instead",
+				}},
+			},
+		},
+		Pass: []string{
+			"function connect({ host, port, user, password }) {}",
+			"function connect(url, { port, user, password } = {}) {}",
+			"function log(level, message, ...args) {}",
+			"function f(this: Window, a, b, c) {}",
+			"const f = x => x",
+		},
+	}
+	testCase.Run(t)
+
+	strict := &TestCase{
+		Name: "prefer-options-object-strict.js",
+		Rule: js_rules.PreferOptionsObject(&js_rules.PreferOptionsObjectOptions{Max: 1}),
+		Raise: []ShouldRaise{
+			{
+				Code: "class A { move(x, y) {} }",
+				Expected: []ExpectedIssue{{
+					Message: "Function has 2 positional parameters, which exceeds the maximum of 1. " +
+						"Consider accepting a single options object, like `{ a, b, c }`, instead",
+				}},
+			},
+		},
+		Pass: []string{"class A { move(x) {} }"},
+	}
+	strict.Run(t)
+}
@@ -0,0 +1,42 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreferTemplateLiteral(t *testing.T) {
+	message := "Unexpected string concatenation. Use a template literal instead."
+	testCase := &TestCase{
+		Name: "prefer-template-literal.js",
+		Rule: js_rules.PreferTemplateLiteral(),
+		Raise: []ShouldRaise{
+			{Code: `const s = "Hello " + name`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `const s = "a" + (b + "c") + d`, Expected: []ExpectedIssue{{Message: message}}},
+			{Code: `f(x + 'px')`, Expected: []ExpectedIssue{{Message: message}}},
+		},
+		Pass: []string{
+			`const n = 1 + 2`,
+			`const n = a + b`,
+			`const s = "a" + "b"`,
+			"const s = `a${b}`",
+			`const s = "a" - b`,
+		},
+	}
+	testCase.Run(t)
+
+	fixCases := map[string]string{
+		`s = "Hello " + name + "!"`:         "s = `Hello ${name}!`",
+		`s = 'it\'s ' + (a + b)`:            "s = `it\\'s ${a + b}`",
+		"s = \"`${x}` \" + y":               "s = `\\`\\${x}\\` ${y}`",
+		`s = a + b + " items"`:              "s = `${a + b} items`",
+		`s = "a" + /* why */ b`:             `s = "a" + /* why */ b`,
+		`s = "total: " + sum(xs) + " " + u`: "s = `total: ${sum(xs)} ${u}`",
+	}
+
+	for code, expected := range fixCases {
+		assert.Equal(t, expected, fixedSource(t, js_rules.PreferTemplateLiteral(), code))
+	}
+}
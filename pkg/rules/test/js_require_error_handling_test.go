@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestRequireErrorHandling(t *testing.T) {
+	testCase := &TestCase{
+		Name: "require-error-handling.js",
+		Rule: js_rules.RequireErrorHandling(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `fetch(url).then(res => res.json()).then(render)`,
+				Expected: []ExpectedIssue{{
+					Message: "Promise chain has no '.catch()' handler",
+					Start:   &sitter.Point{Row: 0, Column: 0},
+					End:     &sitter.Point{Row: 0, Column: 10},
+				}},
+			},
+			{
+				Code:     `promise.then(render).finally(done)`,
+				Expected: []ExpectedIssue{{Message: "Promise chain has no '.catch()' handler"}},
+			},
+			{
+				Code:     `function f() { fetch(url) }`,
+				Expected: []ExpectedIssue{{Message: "Promise returned by 'fetch' is not handled"}},
+			},
+			{
+				Code:     `Promise.all(tasks)`,
+				Expected: []ExpectedIssue{{Message: "Promise returned by 'Promise.all' is not handled"}},
+			},
+		},
+		Pass: []string{
+			`fetch(url).then(render).catch(report)`,
+			`promise.then(render, report)`,
+			`const res = fetch(url)`,
+			`async function f() { await fetch(url).then(render) }`,
+			`return fetch(url)`,
+			`readFileAsync(path)`,
+		},
+	}
+	testCase.Run(t)
+
+	customCase := &TestCase{
+		Name: "require-error-handling-custom.js",
+		Rule: js_rules.RequireErrorHandling(&js_rules.RequireErrorHandlingOptions{
+			PromiseFunctions: []string{"*Async", "axios.*"},
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code: "readFileAsync(path)\naxios.get(url)",
+				Expected: []ExpectedIssue{
+					{Message: "Promise returned by 'readFileAsync' is not handled"},
+					{Message: "Promise returned by 'axios.get' is not handled"},
+				},
+			},
+		},
+		Pass: []string{`axios.get(url).catch(report)`},
+	}
+	customCase.Run(t)
+}
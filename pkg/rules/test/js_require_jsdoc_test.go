@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestRequireJsDoc(t *testing.T) {
+	testCase := &TestCase{
+		Name: "require-jsdoc.js",
+		Rule: js_rules.RequireJsDoc(nil),
+		Raise: []ShouldRaise{
+			{
+				Code:     `export function f() {}`,
+				Expected: []ExpectedIssue{{Message: "Missing JSDoc comment for exported function 'f'"}},
+			},
+			{
+				Code:     "// not a doc comment\nexport class A {}",
+				Expected: []ExpectedIssue{{Message: "Missing JSDoc comment for exported class 'A'"}},
+			},
+			{
+				Code:     "/** too far away */\n\nexport const g = () => 1",
+				Expected: []ExpectedIssue{{Message: "Missing JSDoc comment for exported function 'g'"}},
+			},
+		},
+		Pass: []string{
+			"/** Does things. */\nexport function f() {}",
+			"/**\n * A thing.\n */\nexport class A {}",
+			"/** Returns one. */\nexport const g = () => 1",
+			`function f() {}`,
+			`export const x = 1`,
+		},
+	}
+	testCase.Run(t)
+
+	includeUnexported := &TestCase{
+		Name: "require-jsdoc-unexported.js",
+		Rule: js_rules.RequireJsDoc(&js_rules.RequireJsDocOptions{IncludeUnexported: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `function f() { function inner() {} }`,
+				Expected: []ExpectedIssue{{Message: "Missing JSDoc comment for top-level function 'f'"}},
+			},
+		},
+		Pass: []string{"/** Does things. */\nfunction f() {}"},
+	}
+	includeUnexported.Run(t)
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireStrictEquality(t *testing.T) {
+	testCase := &TestCase{
+		Name: "require-strict-equality.js",
+		Rule: js_rules.RequireStrictEquality(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "if (a == b) {}",
+				Expected: []ExpectedIssue{{
+					Message: "Use '===' instead of '==' to compare without type coercion",
+					Start:   &sitter.Point{Row: 0, Column: 6},
+					End:     &sitter.Point{Row: 0, Column: 8},
+				}},
+			},
+			{
+				Code: "x != 1; y == null",
+				Expected: []ExpectedIssue{
+					{Message: "Use '!==' instead of '!=' to compare without type coercion"},
+					{Message: "Use '===' instead of '==' to compare without type coercion"},
+				},
+			},
+		},
+		Pass: []string{"a === b", "a !== b", "a = b", "a <= b"},
+	}
+	testCase.Run(t)
+
+	allowNull := &TestCase{
+		Name: "require-strict-equality-null.js",
+		Rule: js_rules.RequireStrictEquality(&js_rules.RequireStrictEqualityOptions{AllowNullComparison: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "x == undefined",
+				Expected: []ExpectedIssue{{Message: "Use '===' instead of '==' to compare without type coercion"}},
+			},
+		},
+		Pass: []string{"x == null", "null != x", "x != (null)"},
+	}
+	allowNull.Run(t)
+
+	rule := js_rules.RequireStrictEquality(nil)
+	assert.Equal(t, `if (typeof a === "string" && 1 !== 2) {}`, fixedSource(t, rule, `if (typeof a == "string" && 1 != 2) {}`))
+
+	// the fix is only offered when both operands are known to have the same type
+	fixable := map[string]bool{
+		`typeof x == "string"`: true,
+		`"a" != 'b'`:           true,
+		"1 == 2":               true,
+		"x == 1":               false,
+		`1 == "1"`:             false,
+		"x == null":            false,
+		"x != null":            false,
+	}
+	for code, hasFix := range fixable {
+		parsed, err := one.ParseString(code, one.LangJs)
+		require.NoError(t, err)
+
+		issues := one.NewAnalyzer(parsed, []one.Rule{rule}).Analyze()
+		require.Len(t, issues, 1, code)
+		if hasFix {
+			require.NotNil(t, issues[0].Fix, code)
+			assert.Equal(t, "high", issues[0].Metadata[one.MetadataFixConfidence], code)
+		} else {
+			assert.Nil(t, issues[0].Fix, code)
+			assert.Equal(t, code, fixedSource(t, rule, code))
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package rules
+
+import (
+	"testing"
+
+	py_rules "github.com/srijan-paul/deepgrep/pkg/rules/python"
+)
+
+func TestPyMaxParams(t *testing.T) {
+	testCase := &TestCase{
+		Name: "max-params.py",
+		Rule: py_rules.MaxParams(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `
+def f(a, b, c, d, e, *args):
+    pass`,
+				Expected: []ExpectedIssue{{Message: "Function has 6 parameters, which exceeds the maximum of 5"}},
+			},
+		},
+		Pass: []string{
+			`
+def f(a, b, *, c, d, e):
+    pass`,
+			`
+class A:
+    def f(self, a, b, c, d, **kwargs):
+        pass`,
+		},
+	}
+	testCase.Run(t)
+}
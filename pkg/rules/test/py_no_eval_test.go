@@ -0,0 +1,35 @@
+package rules
+
+import (
+	"testing"
+
+	python_rules "github.com/srijan-paul/deepgrep/pkg/rules/python"
+)
+
+func TestPyNoEval(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no_eval.py",
+		Rule: python_rules.NoEval(&python_rules.NoEvalOptions{Functions: []string{"os.system"}}),
+		Raise: []ShouldRaise{
+			{
+				Code: `eval("1 + 1")`,
+				Expected: []ExpectedIssue{{
+					Message: "Unexpected call to 'eval'. Evaluating code at runtime is a security hazard.",
+				}},
+			},
+			{
+				Code: "exec(code)\nos.system(cmd)",
+				Expected: []ExpectedIssue{
+					{Message: "Unexpected call to 'exec'. Evaluating code at runtime is a security hazard."},
+					{Message: "Unexpected call to 'os.system'. Evaluating code at runtime is a security hazard."},
+				},
+			},
+		},
+		Pass: []string{
+			`literal_eval("1 + 1")`,
+			`ast.literal_eval(src)`,
+			`os.path.join(a, b)`,
+		},
+	}
+	testCase.Run(t)
+}
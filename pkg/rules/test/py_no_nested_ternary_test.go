@@ -0,0 +1,25 @@
+package rules
+
+import (
+	"testing"
+
+	py_rules "github.com/srijan-paul/deepgrep/pkg/rules/python"
+)
+
+func TestPyNoNestedTernary(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-nested-ternary.py",
+		Rule: py_rules.NoNestedTernary(nil),
+		Raise: []ShouldRaise{
+			{
+				Code:     `x = a if b else c if d else e`,
+				Expected: []ExpectedIssue{{Message: "Do not nest conditional expressions. Use an if-else statement instead"}},
+			},
+		},
+		Pass: []string{
+			`x = a if b else c`,
+			`x = a if (b if c else d) else e`,
+		},
+	}
+	testCase.Run(t)
+}
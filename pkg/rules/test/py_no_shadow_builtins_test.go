@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"testing"
+
+	py_rules "github.com/srijan-paul/deepgrep/pkg/rules/python"
+)
+
+func TestPyNoShadowBuiltins(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-shadow-builtins.py",
+		Rule: py_rules.NoShadowBuiltins(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `
+def f(list, id=1, *dict):
+    pass`,
+				Expected: []ExpectedIssue{
+					{Message: "'list' shadows a builtin"},
+					{Message: "'id' shadows a builtin"},
+					{Message: "'dict' shadows a builtin"},
+				},
+			},
+			{
+				Code: `
+def f():
+    str, x = "a", 1
+    def len(): pass`,
+				Expected: []ExpectedIssue{
+					{Message: "'str' shadows a builtin"},
+					{Message: "'len' shadows a builtin"},
+				},
+			},
+		},
+		Pass: []string{
+			`list = [1, 2]`,
+			`
+class A:
+    id = 1`,
+			`
+def f(items):
+    return list(items)`,
+		},
+	}
+	testCase.Run(t)
+}
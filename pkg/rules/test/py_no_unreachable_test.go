@@ -0,0 +1,40 @@
+package rules
+
+import (
+	"testing"
+
+	py_rules "github.com/srijan-paul/deepgrep/pkg/rules/python"
+)
+
+func TestPyNoUnreachable(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-unreachable.py",
+		Rule: py_rules.NoUnreachable(),
+		Raise: []ShouldRaise{
+			{
+				Code: `
+def foo():
+    return 1
+    x = 2
+    y = 3`,
+				Expected: []ExpectedIssue{{Message: "Unreachable code"}},
+			},
+			{
+				Code: `
+for x in xs:
+    raise ValueError()
+    print(x)`,
+				Expected: []ExpectedIssue{{Message: "Unreachable code"}},
+			},
+		},
+		Pass: []string{
+			`
+def foo(x):
+    if x:
+        return 1
+    return 2`,
+		},
+	}
+
+	testCase.Run(t)
+}
@@ -0,0 +1,47 @@
+package rules
+
+import (
+	"testing"
+
+	python_rules "github.com/srijan-paul/deepgrep/pkg/rules/python"
+)
+
+func TestPyRequireDocstring(t *testing.T) {
+	testCase := &TestCase{
+		Name: "require_docstring.py",
+		Rule: python_rules.RequireDocstring(nil),
+		Raise: []ShouldRaise{
+			{
+				Code:     "def f():\n    return 1",
+				Expected: []ExpectedIssue{{Message: "Missing docstring for function 'f'"}},
+			},
+			{
+				Code:     "class A:\n    def m(self):\n        '''Does things.'''\n",
+				Expected: []ExpectedIssue{{Message: "Missing docstring for class 'A'"}},
+			},
+			{
+				Code:     "@decorator\ndef f():\n    x = 'not a docstring'",
+				Expected: []ExpectedIssue{{Message: "Missing docstring for function 'f'"}},
+			},
+		},
+		Pass: []string{
+			"def f():\n    \"\"\"Returns one.\"\"\"\n    return 1",
+			"def _helper():\n    pass",
+			"class A:\n    \"Stuff.\"\n    def __init__(self):\n        pass",
+			"def f():\n    \"Makes g.\"\n    def g():\n        pass\n    return g",
+		},
+	}
+	testCase.Run(t)
+
+	includePrivate := &TestCase{
+		Name: "require_docstring_private.py",
+		Rule: python_rules.RequireDocstring(&python_rules.RequireDocstringOptions{IncludePrivate: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "def _helper():\n    pass",
+				Expected: []ExpectedIssue{{Message: "Missing docstring for function '_helper'"}},
+			},
+		},
+	}
+	includePrivate.Run(t)
+}
@@ -0,0 +1,58 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	text_rules "github.com/srijan-paul/deepgrep/pkg/rules/text"
+)
+
+func TestIndentation(t *testing.T) {
+	inferred := &TestCase{
+		Name: "indentation.js",
+		Rule: text_rules.Indentation(one.LangJs, nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "function f() {\n\treturn 1\n}\nfunction g() {\n  return 2\n}",
+				Expected: []ExpectedIssue{{
+					Message: "Expected indentation with tabs, but found spaces",
+					Start:   &sitter.Point{Row: 4, Column: 0},
+					End:     &sitter.Point{Row: 4, Column: 2},
+				}},
+			},
+			{
+				Code:     "if (x) {\n \tfoo()\n}",
+				Expected: []ExpectedIssue{{Message: "Mixed tabs and spaces in indentation"}},
+			},
+		},
+		Pass: []string{
+			"function f() {\n  return 1\n}",
+			// multi-line strings and comments are left alone
+			"function f() {\n  /**\n\t * doc\n\t */\n  return `a\n\tb`\n}",
+		},
+	}
+	inferred.Run(t)
+
+	configured := &TestCase{
+		Name: "indentation.py",
+		Rule: text_rules.Indentation(one.LangPy, &text_rules.IndentationOptions{
+			Indent: text_rules.IndentSpaces,
+			Width:  4,
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "def f():\n\treturn 1",
+				Expected: []ExpectedIssue{{Message: "Expected indentation with spaces, but found tabs"}},
+			},
+			{
+				Code:     "def f():\n  return 1",
+				Expected: []ExpectedIssue{{Message: "Indentation of 2 spaces is not a multiple of 4"}},
+			},
+		},
+		Pass: []string{
+			"def f():\n    \"\"\"\n  docstring\n    \"\"\"\n    return 1",
+		},
+	}
+	configured.Run(t)
+}
@@ -0,0 +1,49 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	text_rules "github.com/srijan-paul/deepgrep/pkg/rules/text"
+)
+
+func TestMaxLines(t *testing.T) {
+	code := "// header\n\nlet a = 1\n\n/*\n * doc\n */\nlet b = 2 // trailing\n"
+
+	testCase := &TestCase{
+		Name: "max-lines.js",
+		Rule: text_rules.MaxLines(one.LangJs, &text_rules.MaxLinesOptions{Max: 5}),
+		Raise: []ShouldRaise{
+			{
+				Code: code,
+				Expected: []ExpectedIssue{{
+					Message: "File has 8 lines, which exceeds the maximum of 5",
+					Start:   &sitter.Point{Row: 0, Column: 0},
+				}},
+			},
+		},
+		Pass: []string{"a\nb\nc\nd\ne\n", ""},
+	}
+	testCase.Run(t)
+
+	skipBlank := &TestCase{
+		Name: "max-lines.js",
+		Rule: text_rules.MaxLines(one.LangJs, &text_rules.MaxLinesOptions{Max: 5, SkipBlankLines: true}),
+		Raise: []ShouldRaise{
+			{Code: code, Expected: []ExpectedIssue{{Message: "File has 6 lines, which exceeds the maximum of 5"}}},
+		},
+	}
+	skipBlank.Run(t)
+
+	skipAll := &TestCase{
+		Name: "max-lines.js",
+		Rule: text_rules.MaxLines(one.LangJs, &text_rules.MaxLinesOptions{
+			Max:            2,
+			SkipBlankLines: true,
+			SkipComments:   true,
+		}),
+		Pass: []string{code},
+	}
+	skipAll.Run(t)
+}
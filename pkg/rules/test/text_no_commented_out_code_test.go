@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	text_rules "github.com/srijan-paul/deepgrep/pkg/rules/text"
+)
+
+func TestNoCommentedOutCode(t *testing.T) {
+	message := "This comment looks like commented-out code. Remove it, or restore it if it's needed"
+	testCase := &TestCase{
+		Name: "no-commented-out-code.js",
+		Rule: text_rules.NoCommentedOutCode(one.LangJs, nil),
+		Raise: []ShouldRaise{
+			{
+				Code:     "// console.log(user);\nlet a = 1",
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+			{
+				Code: "let a = 1\n// if (a > 1) {\n//   a = compute(a)\n// }",
+				Expected: []ExpectedIssue{{
+					Message: message,
+					Start:   &sitter.Point{Row: 1, Column: 0},
+					End:     &sitter.Point{Row: 3, Column: 4},
+				}},
+			},
+			{
+				Code:     "/*\n * const total = items.reduce((a, b) => a + b, 0);\n */",
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+		},
+		Pass: []string{
+			"// Compute the total price (including taxes).",
+			"// TODO: handle errors",
+			"// eslint-disable-next-line no-console",
+			"/** Example: `add(1, 2)` */",
+			"// See https://example.com/docs",
+			"// returns true if the user is an admin",
+			"// Note: (this) is important",
+			"// e.g. a = b",
+		},
+	}
+	testCase.Run(t)
+
+	python := &TestCase{
+		Name: "no-commented-out-code.py",
+		Rule: text_rules.NoCommentedOutCode(one.LangPy, nil),
+		Raise: []ShouldRaise{
+			{
+				Code:     "# print(result)\nx = 1",
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+		},
+		Pass: []string{
+			"# Keep the list sorted (by name).",
+			"x = 1  # type: ignore[assignment]",
+		},
+	}
+	python.Run(t)
+
+	lenient := &TestCase{
+		Name: "no-commented-out-code-lenient.js",
+		Rule: text_rules.NoCommentedOutCode(one.LangJs, &text_rules.NoCommentedOutCodeOptions{Confidence: 0.7}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "// const user = getUser(id) }",
+				Expected: []ExpectedIssue{{Message: message}},
+			},
+		},
+		Pass: []string{
+			// the whole statement is inside an ERROR node.
+			"// const user = getUser(id) ]",
+		},
+	}
+	lenient.Run(t)
+}
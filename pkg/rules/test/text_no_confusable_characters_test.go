@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	text_rules "github.com/srijan-paul/deepgrep/pkg/rules/text"
+)
+
+func TestNoConfusableCharacters(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-confusable-characters.js",
+		Rule: text_rules.NoConfusableCharacters(one.LangJs, nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "const isAdmin = false /* \u202E } \u2066if (isAdmin)\u2069 \u2066 begin admins only */",
+				Expected: []ExpectedIssue{{
+					Message: "Dangerous Unicode character U+202E (RIGHT-TO-LEFT OVERRIDE) in comment",
+					Start:   &sitter.Point{Row: 0, Column: 22},
+				}},
+			},
+			{
+				Code:     "const accessLevel = \"user\u202E \u2066// Check if admin\u2069 \u2066\"",
+				Expected: []ExpectedIssue{{Message: "Dangerous Unicode character U+202E (RIGHT-TO-LEFT OVERRIDE) in string"}},
+			},
+			{
+				Code:     "let a\u200Db = 1",
+				Expected: []ExpectedIssue{{Message: "Dangerous Unicode character U+200D (ZERO WIDTH JOINER) in identifier"}},
+			},
+			{
+				Code:     "// is\u200DAdmin",
+				Expected: []ExpectedIssue{{Message: "Dangerous Unicode character U+200D (ZERO WIDTH JOINER) in comment"}},
+			},
+			{
+				// the first letter is a Cyrillic 'а'
+				Code:     "const аdmin = true",
+				Expected: []ExpectedIssue{{Message: "Identifier 'аdmin' mixes characters from the Cyrillic and Latin scripts"}},
+			},
+		},
+		Pass: []string{
+			"const admin = true",
+			"const привет = 'мир'",
+			"// комментарий with English words",
+			"const π = Math.PI",
+			"const family = \"\U0001F468\u200D\U0001F469\u200D\U0001F467\"",
+			// Persian text uses the zero width non-joiner inside words.
+			"const greeting = `\u0645\u06CC\u200C\u062E\u0648\u0627\u0647\u0645`",
+		},
+	}
+	testCase.Run(t)
+
+	custom := &TestCase{
+		Name: "no-confusable-characters.py",
+		Rule: text_rules.NoConfusableCharacters(one.LangPy, &text_rules.NoConfusableCharactersOptions{
+			Characters:        []rune{'\u00AD'},
+			AllowMixedScripts: true,
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "name = 'soft\u00ADhyphen'",
+				Expected: []ExpectedIssue{{Message: "Dangerous Unicode character U+00AD in string"}},
+			},
+			{
+				Code:     "x = 1  # \u202E",
+				Expected: []ExpectedIssue{{Message: "Dangerous Unicode character U+202E (RIGHT-TO-LEFT OVERRIDE) in comment"}},
+			},
+		},
+		Pass: []string{"аdmin = True"},
+	}
+	custom.Run(t)
+}
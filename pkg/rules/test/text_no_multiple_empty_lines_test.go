@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	text_rules "github.com/srijan-paul/deepgrep/pkg/rules/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoMultipleEmptyLines(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-multiple-empty-lines.js",
+		Rule: text_rules.NoMultipleEmptyLines(one.LangJs, nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "a\n\n\n\n\nb\n",
+				Expected: []ExpectedIssue{{
+					Message: "More than 2 blank lines not allowed",
+					Start:   &sitter.Point{Row: 3, Column: 0},
+					End:     &sitter.Point{Row: 5, Column: 0},
+				}},
+			},
+			{
+				Code: "a\n\n  \n\t\nb\n\n\n\n",
+				Expected: []ExpectedIssue{
+					{Message: "More than 2 blank lines not allowed"},
+					{Message: "Too many blank lines at the end of file. Max of 2 allowed"},
+				},
+			},
+		},
+		Pass: []string{
+			"a\n\n\nb\n",
+			"let s = `a\n\n\n\n\nb`\n",
+		},
+	}
+	testCase.Run(t)
+
+	zero := 0
+	atEOF := &TestCase{
+		Name: "no-multiple-empty-lines.py",
+		Rule: text_rules.NoMultipleEmptyLines(one.LangPy, &text_rules.NoMultipleEmptyLinesOptions{
+			Max:      1,
+			MaxAtEOF: &zero,
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "a = 1\n\nb = 2\n\n",
+				Expected: []ExpectedIssue{{Message: "Too many blank lines at the end of file. Max of 0 allowed"}},
+			},
+		},
+		Pass: []string{"a = 1\n\nb = 2\n", "a = 1"},
+	}
+	atEOF.Run(t)
+}
+
+func TestNoMultipleEmptyLinesFix(t *testing.T) {
+	rule := text_rules.NoMultipleEmptyLines(one.LangJs, &text_rules.NoMultipleEmptyLinesOptions{Max: 1})
+	assert.Equal(t, "a\n\nb\n\nc\n\n", fixedSource(t, rule, "a\n\n\n\nb\n\nc\n\n\n\n"))
+}
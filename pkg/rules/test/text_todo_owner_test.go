@@ -0,0 +1,57 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	text_rules "github.com/srijan-paul/deepgrep/pkg/rules/text"
+)
+
+func TestTodoOwner(t *testing.T) {
+	testCase := &TestCase{
+		Name: "todo-owner.js",
+		Rule: text_rules.TodoOwner(one.LangJs, nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "let a = 1 // TODO: remove this",
+				Expected: []ExpectedIssue{{
+					Message: "'TODO' comment has no owner. Expected format: TODO(owner): ...",
+					Start:   &sitter.Point{Row: 0, Column: 10},
+					End:     &sitter.Point{Row: 0, Column: 30},
+				}},
+			},
+			{
+				Code:     "/*\n * FIXME handle errors\n */",
+				Expected: []ExpectedIssue{{Message: "'FIXME' comment has no owner. Expected format: FIXME(owner): ..."}},
+			},
+			{
+				Code:     "// TODO(): who?",
+				Expected: []ExpectedIssue{{Message: "'TODO' comment has no owner. Expected format: TODO(owner): ..."}},
+			},
+		},
+		Pass: []string{
+			"// TODO(srijan): remove this",
+			"/* FIXME(ops-team): handle errors */",
+			"// TODOS are fine, and so is a todo in lowercase",
+			"const s = 'TODO: not a comment'",
+		},
+	}
+	testCase.Run(t)
+
+	custom := &TestCase{
+		Name: "todo-owner.py",
+		Rule: text_rules.TodoOwner(one.LangPy, &text_rules.TodoOwnerOptions{
+			Tags:   []string{"HACK"},
+			Format: `^ \[[A-Z]+-\d+\]`,
+		}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "x = 1  # HACK(srijan): works for now",
+				Expected: []ExpectedIssue{{Message: "'HACK' comment has no owner. Expected format: HACK followed by text matching `^ \\[[A-Z]+-\\d+\\]`"}},
+			},
+		},
+		Pass: []string{"# TODO [LINT-42] support tabs"},
+	}
+	custom.Run(t)
+}
@@ -0,0 +1,53 @@
+package rules
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoEmptyInterface(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-empty-interface.ts",
+		Rule: js_rules.NoEmptyInterface(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: "interface Foo {}",
+				Expected: []ExpectedIssue{{
+					Message: "Interface 'Foo' is empty, and is equivalent to '{}'",
+					Start:   &sitter.Point{Row: 0, Column: 10},
+					End:     &sitter.Point{Row: 0, Column: 13},
+				}},
+			},
+			{
+				Code: `
+				interface Props extends BaseProps<string> {
+					// TODO
+				}`,
+				Expected: []ExpectedIssue{
+					{Message: "Interface 'Props' is empty, and is equivalent to 'BaseProps<string>'. Use a type alias instead"},
+				},
+			},
+		},
+		Pass: []string{
+			"interface Foo { x: number }",
+			"interface Foo extends A, B {}",
+			"interface Foo { (x: number): string }",
+		},
+	}
+	testCase.Run(t)
+
+	allowExtends := &TestCase{
+		Name: "no-empty-interface-extends.ts",
+		Rule: js_rules.NoEmptyInterface(&js_rules.NoEmptyInterfaceOptions{AllowExtends: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     "interface Foo {}",
+				Expected: []ExpectedIssue{{Message: "Interface 'Foo' is empty, and is equivalent to '{}'"}},
+			},
+		},
+		Pass: []string{"interface Props extends BaseProps {}"},
+	}
+	allowExtends.Run(t)
+}
@@ -0,0 +1,51 @@
+package rules
+
+import (
+	"testing"
+
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+)
+
+func TestNoImplicitAny(t *testing.T) {
+	testCase := &TestCase{
+		Name: "no-implicit-any.ts",
+		Rule: js_rules.NoImplicitAny(nil),
+		Raise: []ShouldRaise{
+			{
+				Code: `function f(a, b: number, c?, ...rest) {}`,
+				Expected: []ExpectedIssue{
+					{Message: "Parameter 'a' implicitly has an 'any' type"},
+					{Message: "Parameter 'c' implicitly has an 'any' type"},
+					{Message: "Parameter '...rest' implicitly has an 'any' type"},
+				},
+			},
+			{
+				Code: `
+				class A { m({ x }) {} }
+				const f = y => y`,
+				Expected: []ExpectedIssue{
+					{Message: "Parameter '{ x }' implicitly has an 'any' type"},
+					{Message: "Parameter 'y' implicitly has an 'any' type"},
+				},
+			},
+		},
+		Pass: []string{
+			`function f(this: Window, a: string, b = 1) {}`,
+			`[1, 2].map((x) => x * 2).filter(y => y)`,
+			`const handler: Handler = (req, res) => {}`,
+		},
+	}
+	testCase.Run(t)
+
+	strict := &TestCase{
+		Name: "no-implicit-any.ts",
+		Rule: js_rules.NoImplicitAny(&js_rules.NoImplicitAnyOptions{CheckDefaulted: true}),
+		Raise: []ShouldRaise{
+			{
+				Code:     `function f(b = 1) {}`,
+				Expected: []ExpectedIssue{{Message: "Parameter 'b' implicitly has an 'any' type"}},
+			},
+		},
+	}
+	strict.Run(t)
+}
@@ -94,7 +94,6 @@ func (testCase *TestCase) Run(t *testing.T) {
 
 		analyzer := one.NewAnalyzer(parseResult, []one.Rule{testCase.Rule})
 		require.NotNil(t, analyzer)
-		analyzer.AddRule(testCase.Rule)
 
 		got := analyzer.Analyze()
 		if len(got) > 0 {
@@ -102,3 +101,13 @@ func (testCase *TestCase) Run(t *testing.T) {
 		}
 	}
 }
+
+// fixedSource runs `rule` on `code`, and returns the result of applying all fixes.
+func fixedSource(t *testing.T, rule one.Rule, code string) string {
+	lang := rule.GetLanguage()
+	parseResult, err := one.Parse("fix", []byte(code), lang, lang.Grammar())
+	require.NoError(t, err)
+
+	issues := one.NewAnalyzer(parseResult, []one.Rule{rule}).Analyze()
+	return string(one.ApplyFixes(parseResult.Source, issues))
+}
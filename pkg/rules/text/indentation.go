@@ -0,0 +1,90 @@
+package text_rules
+
+import (
+	"bytes"
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+const (
+	IndentTabs   = "tabs"
+	IndentSpaces = "spaces"
+)
+
+type IndentationOptions struct {
+	// Indent is either `IndentTabs` or `IndentSpaces`.
+	// When empty, the style of the first indented line in a file is expected throughout.
+	Indent string
+	// Width is the number of spaces per indentation level.
+	// When 0, the width is not checked. Ignored when indenting with tabs.
+	Width int
+}
+
+var defaultIndentationOptions = IndentationOptions{}
+
+func indentStyle(indent []byte) string {
+	if indent[0] == '\t' {
+		return IndentTabs
+	}
+	return IndentSpaces
+}
+
+func checkIndentation(opts *IndentationOptions, ana *one.Analyzer, root *sitter.Node) {
+	verbatim := verbatimRanges(ana)
+	expected := opts.Indent
+
+	for _, line := range splitLines(ana.ParseResult.Source) {
+		if line.isBlank() || line.isVerbatim(verbatim) {
+			continue
+		}
+
+		trimmed := bytes.TrimLeft(line.text, " \t")
+		indent := line.text[:len(line.text)-len(trimmed)]
+		if len(indent) == 0 {
+			continue
+		}
+
+		if bytes.ContainsRune(indent, ' ') && bytes.ContainsRune(indent, '\t') {
+			ana.Report(&one.Issue{
+				Message: "Mixed tabs and spaces in indentation",
+				Range:   line.rangeOf(0, len(indent)),
+			})
+			continue
+		}
+
+		style := indentStyle(indent)
+		if expected == "" {
+			expected = style
+		}
+
+		if style != expected {
+			ana.Report(&one.Issue{
+				Message: fmt.Sprintf("Expected indentation with %s, but found %s", expected, style),
+				Range:   line.rangeOf(0, len(indent)),
+			})
+			continue
+		}
+
+		if style == IndentSpaces && opts.Width > 0 && len(indent)%opts.Width != 0 {
+			ana.Report(&one.Issue{
+				Message: fmt.Sprintf("Indentation of %d spaces is not a multiple of %d", len(indent), opts.Width),
+				Range:   line.rangeOf(0, len(indent)),
+			})
+		}
+	}
+}
+
+// Indentation flags lines that are indented inconsistently with the rest of the file.
+// Lines that begin inside multi-line strings and comments are ignored.
+// When `opts` is nil, the default options are used.
+func Indentation(language one.Language, opts *IndentationOptions) one.Rule {
+	if opts == nil {
+		opts = &defaultIndentationOptions
+	}
+
+	return one.CreateTextRule("indentation", language, func(r one.Rule, ana *one.Analyzer, root *sitter.Node) {
+		checkIndentation(opts, ana, root)
+	})
+}
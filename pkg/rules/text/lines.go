@@ -0,0 +1,107 @@
+package text_rules
+
+import (
+	"bytes"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// line is a single line of source text, without the trailing newline.
+type line struct {
+	// row is the 0-based line number
+	row int
+	// offset is the byte offset of the first character of this line
+	offset int
+	text   []byte
+}
+
+func splitLines(source []byte) []line {
+	var lines []line
+	offset := 0
+	for row, text := range bytes.Split(source, []byte("\n")) {
+		lines = append(lines, line{
+			row:    row,
+			offset: offset,
+			text:   bytes.TrimSuffix(text, []byte("\r")),
+		})
+		offset += len(text) + 1
+	}
+
+	return lines
+}
+
+// verbatimNodeTypes lists the node types whose contents should be left alone
+// by text rules. e.g: whitespace in a multi-line string is meaningful, not formatting.
+var verbatimNodeTypes = map[one.Language][]string{
+	one.LangPy:  {"string", "comment"},
+	one.LangJs:  {"string", "template_string", "comment"},
+	one.LangTs:  {"string", "template_string", "comment"},
+	one.LangTsx: {"string", "template_string", "comment"},
+}
+
+// verbatimRanges returns the ranges of all multi-line nodes in the file
+// whose contents should be ignored by text rules.
+func verbatimRanges(ana *one.Analyzer) []sitter.Range {
+	var ranges []sitter.Range
+	for _, nodeType := range verbatimNodeTypes[ana.Language] {
+		for _, node := range ana.ParseResult.FindAll(nodeType) {
+			if node.StartPoint().Row != node.EndPoint().Row {
+				ranges = append(ranges, node.Range())
+			}
+		}
+	}
+
+	return ranges
+}
+
+// commentRanges returns the ranges of all comments in the file.
+func commentRanges(ana *one.Analyzer) []sitter.Range {
+	var ranges []sitter.Range
+	for _, node := range ana.ParseResult.FindAll("comment") {
+		ranges = append(ranges, node.Range())
+	}
+
+	return ranges
+}
+
+// isComment returns true if the (non-whitespace) text of this line is entirely
+// inside one of the comment ranges.
+func (l line) isComment(comments []sitter.Range) bool {
+	trimmedLeft := bytes.TrimLeft(l.text, " \t")
+	start := uint32(l.offset + len(l.text) - len(trimmedLeft))
+	end := uint32(l.offset + len(bytes.TrimRight(l.text, " \t")))
+	for _, r := range comments {
+		if start >= r.StartByte && end <= r.EndByte {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isVerbatim returns true if the line begins inside one of the verbatim ranges.
+func (l line) isVerbatim(ranges []sitter.Range) bool {
+	for _, r := range ranges {
+		if uint32(l.offset) > r.StartByte && uint32(l.offset) < r.EndByte {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isBlank returns true if the line contains only whitespace.
+func (l line) isBlank() bool {
+	return len(bytes.TrimSpace(l.text)) == 0
+}
+
+// rangeOf returns the range spanning columns [start, end) of the line.
+func (l line) rangeOf(start, end int) sitter.Range {
+	return sitter.Range{
+		StartPoint: sitter.Point{Row: uint32(l.row), Column: uint32(start)},
+		EndPoint:   sitter.Point{Row: uint32(l.row), Column: uint32(end)},
+		StartByte:  uint32(l.offset + start),
+		EndByte:    uint32(l.offset + end),
+	}
+}
@@ -0,0 +1,77 @@
+package text_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type MaxLinesOptions struct {
+	// Max is the maximum number of lines allowed in a file.
+	// Defaults to 1000 when 0.
+	Max int
+	// SkipBlankLines excludes lines with only whitespace from the count.
+	SkipBlankLines bool
+	// SkipComments excludes lines that only contain comments from the count.
+	SkipComments bool
+}
+
+const defaultMaxLines = 1000
+
+func checkMaxLines(opts *MaxLinesOptions, ana *one.Analyzer, root *sitter.Node) {
+	max := opts.Max
+	if max == 0 {
+		max = defaultMaxLines
+	}
+
+	lines := splitLines(ana.ParseResult.Source)
+	// a trailing newline doesn't start a new line
+	if last := lines[len(lines)-1]; len(last.text) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) == 0 {
+		return
+	}
+
+	var comments []sitter.Range
+	if opts.SkipComments {
+		comments = commentRanges(ana)
+	}
+
+	count := 0
+	for _, line := range lines {
+		if line.isBlank() {
+			if !opts.SkipBlankLines {
+				count++
+			}
+			continue
+		}
+
+		if opts.SkipComments && line.isComment(comments) {
+			continue
+		}
+
+		count++
+	}
+
+	if count > max {
+		ana.Report(&one.Issue{
+			Message: fmt.Sprintf("File has %d lines, which exceeds the maximum of %d", count, max),
+			Range:   lines[0].rangeOf(0, len(lines[0].text)),
+		})
+	}
+}
+
+// MaxLines flags files that have too many lines.
+// When `opts` is nil, the default options are used.
+func MaxLines(language one.Language, opts *MaxLinesOptions) one.Rule {
+	if opts == nil {
+		opts = &MaxLinesOptions{}
+	}
+
+	return one.CreateTextRule("max-lines", language, func(r one.Rule, ana *one.Analyzer, root *sitter.Node) {
+		checkMaxLines(opts, ana, root)
+	})
+}
@@ -0,0 +1,199 @@
+package text_rules
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoCommentedOutCodeOptions struct {
+	// Confidence is how much of a comment (between 0 and 1) must parse without
+	// syntax errors for it to be reported as code.
+	// Defaults to 1 when 0, i.e: the entire comment must be valid code.
+	// Lower values catch code with typos or partial statements, but flag more prose.
+	Confidence float64
+}
+
+const defaultCodeConfidence = 1.0
+
+// codePunctuation are characters that almost all code has,
+// but prose rarely does. Comments without any are never reported.
+const codePunctuation = "(){}[];="
+
+// directiveRegexp matches comments that are instructions to tools, rather than code.
+var directiveRegexp = regexp.MustCompile(`^\s*(onelint-|eslint|@ts-|prettier-|noqa|type:|pylint:|fmt:|istanbul )`)
+
+// proseRegexp matches comments that start like prose.
+// `e.g. a = b` is a valid assignment to `e.g.a`, but it's an example, not code.
+var proseRegexp = regexp.MustCompile(`(?i)^\s*(e\.g\.|i\.e\.|n\.b\.|note:)`)
+
+// lineCommentMarker returns the marker that starts a single line comment, or "" for block comments.
+func lineCommentMarker(text string) string {
+	for _, marker := range []string{"//", "#", "--"} {
+		if strings.HasPrefix(text, marker) && !strings.HasPrefix(text, "--[[") {
+			return marker
+		}
+	}
+
+	return ""
+}
+
+// commentBody returns the text of a comment without the comment markers.
+func commentBody(text string) string {
+	if marker := lineCommentMarker(text); marker != "" {
+		return strings.TrimPrefix(text, marker)
+	}
+
+	for _, delims := range [][2]string{{"/*", "*/"}, {"--[[", "]]"}} {
+		if !strings.HasPrefix(text, delims[0]) {
+			continue
+		}
+
+		body := strings.TrimSuffix(strings.TrimPrefix(text, delims[0]), delims[1])
+		lines := strings.Split(body, "\n")
+		for i, line := range lines {
+			// the leading `*` on every line of a block comment
+			trimmed := strings.TrimLeft(line, " \t")
+			if i > 0 && strings.HasPrefix(trimmed, "*") {
+				lines[i] = strings.TrimPrefix(trimmed, "*")
+			}
+		}
+
+		return strings.Join(lines, "\n")
+	}
+
+	return text
+}
+
+// commentBlock returns the consecutive line comments that start at `comment`,
+// so that code commented out line-by-line is parsed as a whole.
+// Returns nil if `comment` is not the first comment in its block.
+func commentBlock(comment *sitter.Node, source []byte) []*sitter.Node {
+	marker := lineCommentMarker(comment.Content(source))
+	if marker == "" {
+		return []*sitter.Node{comment}
+	}
+
+	isNextLine := func(prev, next *sitter.Node) bool {
+		return next != nil &&
+			prev.Type() == comment.Type() &&
+			next.Type() == comment.Type() &&
+			next.StartPoint().Row == prev.EndPoint().Row+1 &&
+			lineCommentMarker(next.Content(source)) == marker
+	}
+
+	if prev := comment.PrevSibling(); prev != nil && isNextLine(prev, comment) {
+		return nil
+	}
+
+	block := []*sitter.Node{comment}
+	for next := comment.NextSibling(); isNextLine(block[len(block)-1], next); next = next.NextSibling() {
+		block = append(block, next)
+	}
+
+	return block
+}
+
+// errorCollector is a Walker that counts the bytes covered by syntax errors.
+// Tree-sitter can wrap valid nodes that it couldn't fit into the tree inside ERROR nodes,
+// but those are still part of the error, so the whole ERROR node is counted.
+type errorCollector struct {
+	errorBytes int
+}
+
+func (c *errorCollector) OnEnterNode(node *sitter.Node) bool {
+	if node.IsError() {
+		c.errorBytes += int(node.EndByte() - node.StartByte())
+		// nested errors are already counted.
+		return false
+	}
+
+	// missing nodes are zero-width, so they count as a byte each.
+	if node.IsMissing() {
+		c.errorBytes++
+	}
+
+	return true
+}
+
+func (c *errorCollector) OnLeaveNode(node *sitter.Node) {}
+
+// codeConfidence returns the fraction of `text` that parses as valid code in `grammar`.
+func codeConfidence(text string, grammar *sitter.Language) float64 {
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" || !strings.ContainsAny(trimmed, codePunctuation) {
+		return 0
+	}
+
+	root, err := sitter.ParseCtx(context.Background(), []byte(trimmed), grammar)
+	if err != nil {
+		return 0
+	}
+
+	collector := &errorCollector{}
+	one.WalkAll(root, collector)
+	return max(0, 1-float64(collector.errorBytes)/float64(len(trimmed)))
+}
+
+func checkCommentedOutCode(opts *NoCommentedOutCodeOptions, ana *one.Analyzer, node *sitter.Node) {
+	source := ana.ParseResult.Source
+	text := node.Content(source)
+	// doc comments can contain code examples.
+	if strings.HasPrefix(text, "/**") {
+		return
+	}
+
+	block := commentBlock(node, source)
+	if block == nil {
+		return
+	}
+
+	var lines []string
+	for _, comment := range block {
+		body := commentBody(comment.Content(source))
+		if directiveRegexp.MatchString(body) || proseRegexp.MatchString(body) {
+			return
+		}
+
+		lines = append(lines, body)
+	}
+
+	threshold := opts.Confidence
+	if threshold == 0 {
+		threshold = defaultCodeConfidence
+	}
+
+	if codeConfidence(strings.Join(lines, "\n"), ana.ParseResult.TsLanguage) < threshold {
+		return
+	}
+
+	last := block[len(block)-1]
+	ana.Report(&one.Issue{
+		Message: "This comment looks like commented-out code. Remove it, or restore it if it's needed",
+		Range: sitter.Range{
+			StartPoint: node.StartPoint(),
+			EndPoint:   last.EndPoint(),
+			StartByte:  node.StartByte(),
+			EndByte:    last.EndByte(),
+		},
+		Node: node,
+	})
+}
+
+// NoCommentedOutCode flags comments that parse as code in the language of the file.
+// Consecutive line comments are checked together, and reported as one issue.
+// When `opts` is nil, the default options are used.
+func NoCommentedOutCode(language one.Language, opts *NoCommentedOutCodeOptions) one.Rule {
+	if opts == nil {
+		opts = &NoCommentedOutCodeOptions{}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkCommentedOutCode(opts, ana, node)
+	}
+
+	return one.CreateRule("no-commented-out-code", "comment", language, &entry, nil)
+}
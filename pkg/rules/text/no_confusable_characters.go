@@ -0,0 +1,164 @@
+package text_rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoConfusableCharactersOptions struct {
+	// Characters is a list of code points to flag, in addition to the default
+	// bidirectional control and invisible characters.
+	Characters []rune
+	// AllowMixedScripts disables the check for identifiers that mix
+	// characters from the Latin, Greek and Cyrillic scripts.
+	AllowMixedScripts bool
+}
+
+// dangerousCharacters are code points that change how source code is displayed
+// without changing how it is parsed (see: https://trojansource.codes).
+var dangerousCharacters = map[rune]string{
+	'\u202A': "LEFT-TO-RIGHT EMBEDDING",
+	'\u202B': "RIGHT-TO-LEFT EMBEDDING",
+	'\u202C': "POP DIRECTIONAL FORMATTING",
+	'\u202D': "LEFT-TO-RIGHT OVERRIDE",
+	'\u202E': "RIGHT-TO-LEFT OVERRIDE",
+	'\u2066': "LEFT-TO-RIGHT ISOLATE",
+	'\u2067': "RIGHT-TO-LEFT ISOLATE",
+	'\u2068': "FIRST STRONG ISOLATE",
+	'\u2069': "POP DIRECTIONAL ISOLATE",
+	'\u200E': "LEFT-TO-RIGHT MARK",
+	'\u200F': "RIGHT-TO-LEFT MARK",
+	'\u061C': "ARABIC LETTER MARK",
+	'\u200B': "ZERO WIDTH SPACE",
+	'\u200C': "ZERO WIDTH NON-JOINER",
+	'\u200D': "ZERO WIDTH JOINER",
+	'\u2060': "WORD JOINER",
+	'\uFEFF': "ZERO WIDTH NO-BREAK SPACE",
+}
+
+// joinerCharacters are needed to write emoji sequences (e.g: "👨‍👩‍👧") and text in scripts like Persian.
+// They are only flagged in identifiers and comments, not in strings.
+var joinerCharacters = []rune{'\u200C', '\u200D'}
+
+// stringNodeTypes are the text nodes that hold string values.
+var stringNodeTypes = []string{"string", "template_string"}
+
+// confusableScripts are scripts with letters that look like Latin letters (e.g: Cyrillic 'а' and Latin 'a').
+var confusableScripts = []struct {
+	name  string
+	table *unicode.RangeTable
+}{
+	{"Latin", unicode.Latin},
+	{"Greek", unicode.Greek},
+	{"Cyrillic", unicode.Cyrillic},
+}
+
+// textNodeTypes are the nodes that are checked, across all languages.
+var textNodeTypes = []string{
+	"identifier",
+	"property_identifier",
+	"shorthand_property_identifier",
+	"type_identifier",
+	"comment",
+	"string",
+	"template_string",
+}
+
+var identifierNodeTypes = []string{
+	"identifier",
+	"property_identifier",
+	"shorthand_property_identifier",
+	"type_identifier",
+}
+
+func describeCharacter(char rune, characters map[rune]string) string {
+	if name := characters[char]; name != "" {
+		return fmt.Sprintf("U+%04X (%s)", char, name)
+	}
+
+	return fmt.Sprintf("U+%04X", char)
+}
+
+// scriptsOf returns the names of the confusable scripts that the letters in `text` belong to.
+func scriptsOf(text string) []string {
+	var scripts []string
+	for _, char := range text {
+		for _, script := range confusableScripts {
+			if unicode.Is(script.table, char) && !slices.Contains(scripts, script.name) {
+				scripts = append(scripts, script.name)
+			}
+		}
+	}
+
+	return scripts
+}
+
+func checkConfusableCharacters(
+	characters map[rune]string,
+	opts *NoConfusableCharactersOptions,
+	ana *one.Analyzer,
+	node *sitter.Node,
+) {
+	text := node.Content(ana.ParseResult.Source)
+	isString := slices.Contains(stringNodeTypes, node.Type())
+	for _, char := range text {
+		if isString && slices.Contains(joinerCharacters, char) {
+			continue
+		}
+
+		if _, ok := characters[char]; ok {
+			ana.Report(&one.Issue{
+				Message:  fmt.Sprintf("Dangerous Unicode character %s in %s", describeCharacter(char, characters), node.Type()),
+				Range:    node.Range(),
+				Node:     node,
+				Severity: one.SeverityError,
+			})
+			return
+		}
+	}
+
+	if opts.AllowMixedScripts || !slices.Contains(identifierNodeTypes, node.Type()) {
+		return
+	}
+
+	if scripts := scriptsOf(text); len(scripts) > 1 {
+		ana.Report(&one.Issue{
+			Message:  fmt.Sprintf("Identifier '%s' mixes characters from the %s scripts", text, strings.Join(scripts, " and ")),
+			Range:    node.Range(),
+			Node:     node,
+			Severity: one.SeverityError,
+		})
+	}
+}
+
+// NoConfusableCharacters flags identifiers, comments, and strings that contain bidirectional control
+// or invisible characters, and identifiers that mix look-alike letters from different scripts.
+// Both can be used to make code read differently from how it runs ("Trojan Source" attacks).
+// When `opts` is nil, the default options are used.
+func NoConfusableCharacters(language one.Language, opts *NoConfusableCharactersOptions) one.Rule {
+	if opts == nil {
+		opts = &NoConfusableCharactersOptions{}
+	}
+
+	characters := make(map[rune]string, len(dangerousCharacters)+len(opts.Characters))
+	for char, name := range dangerousCharacters {
+		characters[char] = name
+	}
+
+	for _, char := range opts.Characters {
+		if _, ok := characters[char]; !ok {
+			characters[char] = ""
+		}
+	}
+
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkConfusableCharacters(characters, opts, ana, node)
+	}
+
+	return one.CreateMultiNodeRule("no-confusable-characters", textNodeTypes, language, &entry, nil)
+}
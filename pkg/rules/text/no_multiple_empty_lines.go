@@ -0,0 +1,90 @@
+package text_rules
+
+import (
+	"fmt"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type NoMultipleEmptyLinesOptions struct {
+	// Max is the maximum number of consecutive blank lines.
+	// Defaults to 2 when 0.
+	Max int
+	// MaxAtEOF is the maximum number of blank lines at the end of a file.
+	// When nil, `Max` is used.
+	MaxAtEOF *int
+}
+
+const defaultMaxEmptyLines = 2
+
+func checkMultipleEmptyLines(opts *NoMultipleEmptyLinesOptions, ana *one.Analyzer, root *sitter.Node) {
+	source := ana.ParseResult.Source
+	max := opts.Max
+	if max == 0 {
+		max = defaultMaxEmptyLines
+	}
+
+	maxAtEOF := max
+	if opts.MaxAtEOF != nil {
+		maxAtEOF = *opts.MaxAtEOF
+	}
+
+	lines := splitLines(source)
+	// the trailing newline doesn't start a new (blank) line
+	if last := lines[len(lines)-1]; len(last.text) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+
+	verbatim := verbatimRanges(ana)
+	reportExcess := func(run []line, limit int, end int, message string) {
+		if len(run) <= limit {
+			return
+		}
+
+		excess := run[limit:]
+		endPoint := sitter.Point{Row: uint32(excess[len(excess)-1].row + 1)}
+		if end == len(source) {
+			endPoint = sitter.Point{Row: uint32(excess[len(excess)-1].row), Column: uint32(len(excess[len(excess)-1].text))}
+		}
+
+		issueRange := sitter.Range{
+			StartPoint: sitter.Point{Row: uint32(excess[0].row)},
+			EndPoint:   endPoint,
+			StartByte:  uint32(excess[0].offset),
+			EndByte:    uint32(end),
+		}
+
+		ana.Report(&one.Issue{
+			Message: message,
+			Range:   issueRange,
+			Fix:     &one.Fix{Range: issueRange},
+		})
+	}
+
+	var run []line
+	for _, line := range lines {
+		if line.isBlank() && !line.isVerbatim(verbatim) {
+			run = append(run, line)
+			continue
+		}
+
+		reportExcess(run, max, line.offset, fmt.Sprintf("More than %d blank lines not allowed", max))
+		run = nil
+	}
+
+	reportExcess(run, maxAtEOF, len(source), fmt.Sprintf("Too many blank lines at the end of file. Max of %d allowed", maxAtEOF))
+}
+
+// NoMultipleEmptyLines flags runs of consecutive blank lines
+// longer than the configured maximum.
+// When `opts` is nil, the default options are used.
+func NoMultipleEmptyLines(language one.Language, opts *NoMultipleEmptyLinesOptions) one.Rule {
+	if opts == nil {
+		opts = &NoMultipleEmptyLinesOptions{}
+	}
+
+	return one.CreateTextRule("no-multiple-empty-lines", language, func(r one.Rule, ana *one.Analyzer, root *sitter.Node) {
+		checkMultipleEmptyLines(opts, ana, root)
+	})
+}
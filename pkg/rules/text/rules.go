@@ -0,0 +1,12 @@
+package text_rules
+
+import "github.com/srijan-paul/deepgrep/pkg/one"
+
+// CreateTextRules returns the text rules that are enabled by default for a language.
+// Like the JavaScript rules, only rules that report likely mistakes are enabled by default.
+// Indentation, NoMultipleEmptyLines, MaxLines, TodoOwner and NoCommentedOutCode are opt-in.
+func CreateTextRules(language one.Language) []one.Rule {
+	return []one.Rule{
+		NoConfusableCharacters(language, nil),
+	}
+}
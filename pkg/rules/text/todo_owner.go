@@ -0,0 +1,74 @@
+package text_rules
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	one "github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+type TodoOwnerOptions struct {
+	// Tags is a list of comment tags that need an owner, in addition to `TODO` and `FIXME`.
+	Tags []string
+	// Format is a regular expression that the text right after a tag must match.
+	// Defaults to an owner in parentheses followed by a colon, like `TODO(username): ...`.
+	// TodoOwner panics if the expression is invalid.
+	Format string
+}
+
+var defaultTodoTags = []string{"TODO", "FIXME"}
+
+const defaultTodoFormat = `^\([^()\s]+\):`
+
+// todoTagRegexp returns a regexp that matches any of `tags` as a whole word.
+func todoTagRegexp(tags []string) *regexp.Regexp {
+	quoted := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		quoted = append(quoted, regexp.QuoteMeta(tag))
+	}
+
+	return regexp.MustCompile(`\b(` + strings.Join(quoted, "|") + `)\b`)
+}
+
+func checkTodoOwner(tagRegexp, format *regexp.Regexp, hint func(tag string) string, ana *one.Analyzer, node *sitter.Node) {
+	text := node.Content(ana.ParseResult.Source)
+	for _, match := range tagRegexp.FindAllStringSubmatchIndex(text, -1) {
+		if format.MatchString(text[match[1]:]) {
+			continue
+		}
+
+		tag := text[match[2]:match[3]]
+		ana.Report(&one.Issue{
+			Message: fmt.Sprintf("'%s' comment has no owner. Expected format: %s", tag, hint(tag)),
+			Range:   node.Range(),
+			Node:    node,
+		})
+		return
+	}
+}
+
+// TodoOwner flags `TODO` and `FIXME` comments that don't name an owner, like `TODO(username): ...`.
+// When `opts` is nil, the default options are used.
+func TodoOwner(language one.Language, opts *TodoOwnerOptions) one.Rule {
+	tags := defaultTodoTags
+	format := regexp.MustCompile(defaultTodoFormat)
+	hint := func(tag string) string { return tag + "(owner): ..." }
+
+	if opts != nil {
+		tags = append(slices.Clone(defaultTodoTags), opts.Tags...)
+		if opts.Format != "" {
+			format = regexp.MustCompile(opts.Format)
+			hint = func(tag string) string { return fmt.Sprintf("%s followed by text matching `%s`", tag, opts.Format) }
+		}
+	}
+
+	tagRegexp := todoTagRegexp(tags)
+	var entry one.VisitFn = func(r one.Rule, ana *one.Analyzer, node *sitter.Node) {
+		checkTodoOwner(tagRegexp, format, hint, ana, node)
+	}
+
+	return one.CreateRule("todo-owner", "comment", language, &entry, nil)
+}
@@ -0,0 +1,234 @@
+// Package ruletest is a harness for testing rules.
+//
+// Every test case is parsed, analyzed with a single rule, and the issues that
+// were raised are compared with the expected ones. Expected issues can be listed
+// in the test case, or in the source itself with a comment at the end of the line
+// that the issue is expected on:
+//
+//	eval(code) // want: Unexpected call to 'eval'
+//
+// Like in go/analysis tests, a `want` comment can also list one or more quoted
+// regular expressions, each of which must match the message of an issue on that line:
+//
+//	eval(eval(code)) // want "call to 'eval'" `call to 'eval'`
+package ruletest
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+)
+
+// Issue is an issue that a test case expects to be raised.
+type Issue struct {
+	Message string
+	// Pattern, if set, is matched against the message instead of comparing it to `Message`.
+	Pattern *regexp.Regexp
+	// Start and End are compared with the range of the raised issue, if set.
+	Start *sitter.Point
+	End   *sitter.Point
+	// Line is the (0-based) line the issue must start on, if set.
+	Line *int
+}
+
+type Case struct {
+	// Name is used as the name of the subtest and as the file path of the source.
+	// Defaults to "case-<index>".
+	Name   string
+	Source string
+	// Lang is the language the source is parsed as.
+	// Defaults to the language of the rule.
+	Lang one.Language
+	// Want is the list of issues that must be raised, in addition to
+	// the ones annotated in the source. Issues that aren't expected fail the test.
+	Want []Issue
+}
+
+var (
+	// annotationRegexp matches `want: <message>` and `want "<regexp>"...` comments.
+	// A `want` that's followed by anything else is just a word in a comment.
+	annotationRegexp = regexp.MustCompile("(?://|/\\*|#|--)\\s*want(?:(:)\\s*(.*?)|\\s+([\"`].*?))\\s*(?:\\*/)?$")
+	quotedRegexp     = regexp.MustCompile("^(\"(?:[^\"\\\\]|\\\\.)*\"|`[^`]*`)\\s*")
+)
+
+// parsePatterns parses a list of quoted regular expressions, like `"a.*" "b"`.
+func parsePatterns(text string) ([]*regexp.Regexp, error) {
+	var patterns []*regexp.Regexp
+	for text != "" {
+		match := quotedRegexp.FindStringSubmatch(text)
+		if match == nil {
+			return nil, fmt.Errorf("expected a quoted regexp in want comment, found: %s", text)
+		}
+
+		unquoted, err := strconv.Unquote(match[1])
+		if err != nil {
+			return nil, err
+		}
+
+		pattern, err := regexp.Compile(unquoted)
+		if err != nil {
+			return nil, err
+		}
+
+		patterns = append(patterns, pattern)
+		text = text[len(match[0]):]
+	}
+
+	return patterns, nil
+}
+
+// annotatedIssues returns the issues expected by `want` comments in `source`.
+func annotatedIssues(source string) ([]Issue, error) {
+	var issues []Issue
+	for row, text := range strings.Split(source, "\n") {
+		match := annotationRegexp.FindStringSubmatch(text)
+		if match == nil {
+			continue
+		}
+
+		line := row
+		if match[1] == ":" {
+			issues = append(issues, Issue{Message: match[2], Line: &line})
+			continue
+		}
+
+		patterns, err := parsePatterns(match[3])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", row, err)
+		}
+
+		for _, pattern := range patterns {
+			issues = append(issues, Issue{Pattern: pattern, Line: &line})
+		}
+	}
+
+	return issues, nil
+}
+
+func (want *Issue) matches(issue *one.Issue) bool {
+	messagesMatch := want.Message == issue.Message
+	if want.Pattern != nil {
+		messagesMatch = want.Pattern.MatchString(issue.Message)
+	}
+
+	return messagesMatch &&
+		(want.Line == nil || uint32(*want.Line) == issue.Range.StartPoint.Row) &&
+		(want.Start == nil || *want.Start == issue.Range.StartPoint) &&
+		(want.End == nil || *want.End == issue.Range.EndPoint)
+}
+
+func (want *Issue) String() string {
+	var position string
+	switch {
+	case want.Start != nil:
+		position = fmt.Sprintf("%d:%d", want.Start.Row, want.Start.Column)
+	case want.Line != nil:
+		position = fmt.Sprintf("%d", *want.Line)
+	default:
+		position = "?"
+	}
+
+	if want.Pattern != nil {
+		return fmt.Sprintf("[%s] matching %q", position, want.Pattern)
+	}
+
+	return fmt.Sprintf("[%s] %s", position, want.Message)
+}
+
+func formatIssue(issue *one.Issue) string {
+	return fmt.Sprintf("[%d:%d-%d:%d] %s",
+		issue.Range.StartPoint.Row,
+		issue.Range.StartPoint.Column,
+		issue.Range.EndPoint.Row,
+		issue.Range.EndPoint.Column,
+		issue.Message,
+	)
+}
+
+// Analyze parses `source` as `lang` and returns the issues raised by `rule`.
+func Analyze(rule one.Rule, filePath, source string, lang one.Language) ([]*one.Issue, error) {
+	if lang == one.LangUnknown {
+		lang = rule.GetLanguage()
+	}
+
+	grammar := lang.Grammar()
+	if grammar == nil {
+		return nil, fmt.Errorf("%w: %s", one.ErrUnsupportedLanguage, lang)
+	}
+
+	parsed, err := one.Parse(filePath, []byte(source), lang, grammar)
+	if err != nil {
+		return nil, err
+	}
+
+	return one.NewAnalyzer(parsed, []one.Rule{rule}).Analyze(), nil
+}
+
+// diff returns the expected issues that weren't raised, and the raised issues that weren't expected.
+func diff(want []Issue, got []*one.Issue) (missing []Issue, unexpected []*one.Issue) {
+	matched := make([]bool, len(got))
+	for _, w := range want {
+		found := false
+		for i, issue := range got {
+			if !matched[i] && w.matches(issue) {
+				matched[i], found = true, true
+				break
+			}
+		}
+
+		if !found {
+			missing = append(missing, w)
+		}
+	}
+
+	for i, issue := range got {
+		if !matched[i] {
+			unexpected = append(unexpected, issue)
+		}
+	}
+
+	return missing, unexpected
+}
+
+// Run runs `rule` on every test case, and fails the test if the raised
+// issues don't match the expected ones.
+func Run(t *testing.T, rule one.Rule, cases []Case) {
+	t.Helper()
+	for i, c := range cases {
+		name := c.Name
+		if name == "" {
+			name = fmt.Sprintf("case-%d", i)
+		}
+
+		t.Run(name, func(t *testing.T) {
+			got, err := Analyze(rule, name, c.Source, c.Lang)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, err := annotatedIssues(c.Source)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want = append(want, c.Want...)
+			missing, unexpected := diff(want, got)
+			for _, w := range missing {
+				t.Errorf("expected issue not raised: %s", w.String())
+			}
+
+			for _, issue := range unexpected {
+				t.Errorf("unexpected issue: %s", formatIssue(issue))
+			}
+
+			if len(missing) > 0 || len(unexpected) > 0 {
+				t.Logf("source:\n%s", c.Source)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package ruletest
+
+import (
+	"testing"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/srijan-paul/deepgrep/pkg/one"
+	js_rules "github.com/srijan-paul/deepgrep/pkg/rules/js"
+	python_rules "github.com/srijan-paul/deepgrep/pkg/rules/python"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	Run(t, js_rules.NoEval(nil), []Case{
+		{
+			Name: "annotated.js",
+			Source: `
+eval(a) // want: Unexpected call to 'eval'. Evaluating code at runtime is a security hazard.
+evaluate(b)
+eval(c) /* want: Unexpected call to 'eval'. Evaluating code at runtime is a security hazard. */`,
+		},
+		{
+			Source: `f(); eval(x)`,
+			Want: []Issue{{
+				Message: "Unexpected call to 'eval'. Evaluating code at runtime is a security hazard.",
+				Start:   &sitter.Point{Row: 0, Column: 5},
+				End:     &sitter.Point{Row: 0, Column: 12},
+			}},
+		},
+		{Source: `evaluate(x)`},
+	})
+
+	Run(t, js_rules.NoEval(nil), []Case{
+		{
+			Name:   "patterns.js",
+			Source: "eval(eval(code)) // want \"call to 'eval'\" `^Unexpected`\nf(x) && eval(y) /* want \"\" */",
+		},
+	})
+
+	Run(t, python_rules.NoEval(nil), []Case{
+		{Source: "exec(code)  # want: Unexpected call to 'exec'. Evaluating code at runtime is a security hazard."},
+		{Source: `exec(eval(code))  # want "'exec'" "'eval'"`},
+	})
+}
+
+func TestAnnotatedIssues(t *testing.T) {
+	issues, err := annotatedIssues("a\nb // want: first\nc -- want: second  \n")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(issues))
+	assert.Equal(t, "first", issues[0].Message)
+	assert.Equal(t, 1, *issues[0].Line)
+	assert.Equal(t, "second", issues[1].Message)
+	assert.Equal(t, 2, *issues[1].Line)
+
+	// comments that only mention "want" aren't annotations
+	issues, err = annotatedIssues("f() // want this to pass\ng() // we want: nothing\n# wanted: none")
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestAnnotatedPatterns(t *testing.T) {
+	issues, err := annotatedIssues("f() // want \"^a.*\" `b\"c`\ng() # want \"x\\\\d\"")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(issues))
+	assert.Equal(t, "^a.*", issues[0].Pattern.String())
+	assert.Equal(t, `b"c`, issues[1].Pattern.String())
+	assert.Equal(t, 0, *issues[1].Line)
+	assert.Equal(t, `x\d`, issues[2].Pattern.String())
+	assert.Equal(t, 1, *issues[2].Line)
+
+	_, err = annotatedIssues(`f() // want "a" unquoted`)
+	assert.Error(t, err)
+
+	_, err = annotatedIssues(`f() // want "("`)
+	assert.Error(t, err)
+}
+
+func TestDiff(t *testing.T) {
+	line := 0
+	got, err := Analyze(js_rules.NoEval(nil), "file.js", "eval(a); eval(b)\nx", one.LangUnknown)
+	require.NoError(t, err)
+
+	missing, unexpected := diff([]Issue{
+		{Message: "Unexpected call to 'eval'. Evaluating code at runtime is a security hazard.", Line: &line},
+		{Message: "not raised"},
+	}, got)
+
+	require.Equal(t, 1, len(missing))
+	assert.Equal(t, "not raised", missing[0].Message)
+	require.Equal(t, 1, len(unexpected))
+	assert.Equal(t, uint32(9), unexpected[0].Range.StartPoint.Column)
+}